@@ -0,0 +1,33 @@
+// Command config prints the effective configuration a service would run with,
+// as YAML with secrets masked, after merging the profile files, environment
+// and flags. -env selects the profile.
+//
+//	config [-config dir] [-env name] [-database.dsn ...]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hmlylab/common/config"
+)
+
+func main() {
+	configPath := flag.String("config", ".", "directory containing the config profiles")
+	config.RegisterFlags(flag.CommandLine)
+	flag.Parse()
+
+	cfg, err := config.Load(config.Options{
+		Paths: []string{*configPath},
+		Flags: flag.CommandLine,
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := cfg.Dump(os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,118 @@
+// Command migrate applies, reverts and reports the shared domain schema
+// migrations against the DSN resolved by config.Load. Every config setting
+// can also be given as a flag, e.g. -database.dsn.
+//
+//	migrate [-config dir] up
+//	migrate [-config dir] [-steps n] down
+//	migrate [-config dir] status
+//	migrate [-config dir] unlock
+//
+// unlock removes the lock left by a migrate run that died; use it only when
+// no other run is in progress.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hmlylab/common/config"
+	"github.com/hmlylab/common/database"
+	"github.com/hmlylab/common/logger"
+	"github.com/hmlylab/common/migrate"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+// run does the work of main and returns the exit code, so deferred calls
+// such as closing the database run before the process exits.
+func run() int {
+	configPath := flag.String("config", ".", "directory containing config.yaml or .env.local")
+	steps := flag.Int("steps", 1, "number of migrations to revert with down")
+	config.RegisterFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: migrate [flags] up|down|status|unlock")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return 2
+	}
+
+	cfg, err := config.Load(config.Options{Paths: []string{*configPath}, Flags: flag.CommandLine})
+	if err != nil {
+		logger.Default().Error("Failed to load config", "error", err)
+		return 1
+	}
+	service := cfg.ServiceName
+	if service == "" {
+		service = "migrate"
+	}
+	log := logger.Init(logger.Options{
+		Format:    cfg.Log.Format,
+		Level:     cfg.Log.Level,
+		Service:   service,
+		Env:       cfg.Env,
+		Version:   cfg.Version,
+		AddSource: cfg.Log.AddSource,
+	})
+
+	ctx := context.Background()
+	db, err := database.Open(ctx, database.OptionsFromConfig(cfg))
+	if err != nil {
+		return 1
+	}
+	defer database.Close(db)
+
+	m := migrate.NewMigrator(db, migrate.Migrations()...)
+
+	switch flag.Arg(0) {
+	case "up":
+		n, err := m.Up(ctx)
+		if err != nil {
+			log.Error("Migration failed", "error", err)
+			return 1
+		}
+		log.Info("Migrations applied", "count", n)
+	case "down":
+		n, err := m.Down(ctx, *steps)
+		if err != nil {
+			log.Error("Rollback failed", "error", err)
+			return 1
+		}
+		log.Info("Migrations reverted", "count", n)
+	case "status":
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			log.Error("Failed to read migration status", "error", err)
+			return 1
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+		for _, s := range statuses {
+			state, appliedAt := "pending", ""
+			if s.Applied {
+				state, appliedAt = "applied", s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", s.Version, s.Name, state, appliedAt)
+		}
+		w.Flush()
+	case "unlock":
+		if err := m.Unlock(ctx); err != nil {
+			log.Error("Failed to release migration lock", "error", err)
+			return 1
+		}
+		log.Info("Migration lock released")
+	default:
+		flag.Usage()
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/hmlylab/common/logger"
+)
+
+const (
+	consulKVRoot = "hmly"
+	// consulWaitTime is how long a blocking query waits for a change.
+	consulWaitTime = 5 * time.Minute
+	// consulRetryDelay is the pause after a failed watch query.
+	consulRetryDelay = 5 * time.Second
+)
+
+// ConsulKVPrefix returns the KV prefix the config is read from when
+// consul.kv is set: hmly/<env>/<service_name>/.
+func (c Config) ConsulKVPrefix() string {
+	return consulPrefix(c.Env, c.ServiceName)
+}
+
+func consulPrefix(env, service string) string {
+	return path.Join(consulKVRoot, env, service) + "/"
+}
+
+// newConsulClient creates a client for address, taking the token and TLS
+// settings from the usual CONSUL_* environment variables.
+func newConsulClient(address string) (*api.Client, error) {
+	cfg := api.DefaultConfig()
+	cfg.Address = address
+	return api.NewClient(cfg)
+}
+
+// readConsulKV lists the keys under prefix and maps them to settings. A key
+// below the prefix names a setting with "/" or "." between sections, so
+// database/max_open_conns and database.max_open_conns are the same. Keys that
+// do not name a setting are skipped.
+func readConsulKV(ctx context.Context, client *api.Client, prefix string) (map[string]any, error) {
+	pairs, _, err := client.KV().List(prefix, (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("config: reading consul kv %s: %w", prefix, err)
+	}
+	return consulSettings(pairs, prefix), nil
+}
+
+func consulSettings(pairs api.KVPairs, prefix string) map[string]any {
+	out := make(map[string]any)
+	for _, pair := range pairs {
+		name := strings.TrimPrefix(pair.Key, prefix)
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		key, ok := settingKey(strings.ReplaceAll(name, "/", "."))
+		if !ok {
+			logger.Default().Debug("Skipping unknown consul config key", "key", pair.Key)
+			continue
+		}
+		setNested(out, key, string(pair.Value))
+	}
+	return out
+}
+
+// watchConsul reloads the config whenever the keys under the current
+// config's KV prefix change, using Consul blocking queries. It returns when
+// ctx is done.
+func (w *Watcher) watchConsul(ctx context.Context) {
+	var (
+		prefix, address string
+		index           uint64
+		client          *api.Client
+	)
+	for ctx.Err() == nil {
+		cfg := w.Current()
+		if !cfg.Consul.KV {
+			return
+		}
+		if p := cfg.ConsulKVPrefix(); p != prefix {
+			prefix, index = p, 0
+		}
+
+		// The client is kept between queries and rebuilt only when the
+		// address changes or building it failed.
+		var err error
+		if a := cfg.Consul.Address(); client == nil || a != address {
+			address = a
+			client, err = newConsulClient(a)
+		}
+		var meta *api.QueryMeta
+		if err == nil {
+			opts := &api.QueryOptions{WaitIndex: index, WaitTime: consulWaitTime}
+			_, meta, err = client.KV().List(prefix, opts.WithContext(ctx))
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Default().Warn("Consul config watch failed", "prefix", prefix, "error", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.consulRetry):
+			}
+			continue
+		}
+
+		switch {
+		case index == 0:
+			// The first query only establishes the index.
+			index = meta.LastIndex
+		case meta.LastIndex < index:
+			// The index went backwards, e.g. after a snapshot restore; start
+			// over as Consul recommends.
+			index = 0
+		case meta.LastIndex > index:
+			index = meta.LastIndex
+			if err := w.Reload(); err != nil {
+				logger.Default().Warn("Consul config change not applied", "prefix", prefix, "error", err)
+			}
+		}
+	}
+}
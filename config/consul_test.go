@@ -0,0 +1,185 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeConsul serves the KV list endpoint, including blocking queries.
+type fakeConsul struct {
+	mu      sync.Mutex
+	changed chan struct{}
+	index   uint64
+	kv      map[string]string
+	lists   int
+}
+
+func newFakeConsul(t *testing.T, kv map[string]string) *fakeConsul {
+	f := &fakeConsul{kv: kv, index: 1, changed: make(chan struct{})}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	u, _ := url.Parse(srv.URL)
+	t.Setenv("CONSUL_HOST", u.Hostname())
+	t.Setenv("CONSUL_PORT", u.Port())
+	t.Setenv("CONSUL_KV", "true")
+	return f
+}
+
+func (f *fakeConsul) set(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.kv[key] = value
+	f.index++
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	prefix, ok := strings.CutPrefix(r.URL.Path, "/v1/kv/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	f.mu.Lock()
+	f.lists++
+	if wait, _ := strconv.ParseUint(r.URL.Query().Get("index"), 10, 64); wait != 0 && wait >= f.index {
+		changed := f.changed
+		f.mu.Unlock()
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		}
+		f.mu.Lock()
+	}
+	defer f.mu.Unlock()
+
+	var pairs []map[string]any
+	for key, value := range f.kv {
+		if strings.HasPrefix(key, prefix) {
+			pairs = append(pairs, map[string]any{
+				"Key":   key,
+				"Value": base64.StdEncoding.EncodeToString([]byte(value)),
+			})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i]["Key"].(string) < pairs[j]["Key"].(string) })
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(f.index, 10))
+	if len(pairs) == 0 {
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(pairs)
+}
+
+func TestLoad_ConsulKV(t *testing.T) {
+	newFakeConsul(t, map[string]string{
+		"hmly/prod/meal/port":                    "9000",
+		"hmly/prod/meal/log/level":               "debug",
+		"hmly/prod/meal/database.max_open_conns": "40",
+		"hmly/prod/meal/database/max_idle_conns": "8",
+		"hmly/prod/meal/services/recipes/host":   "recipes",
+		"hmly/prod/meal/unknown":                 "ignored",
+		"hmly/prod/meal/database/":               "",
+		"hmly/dev/meal/port":                     "1111",
+	})
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "service_name: meal\nenv: prod\nport: 8000\nlog:\n  level: warn\ndatabase:\n  dsn: postgres://db.internal/app\n  max_open_conns: 10\nauth:\n  clerk_api_key: sk\n")
+	writeFile(t, dir, ".env.local", "DB_MAX_IDLE_CONNS=2\n")
+	t.Setenv("LOG_LEVEL", "error")
+
+	cfg, err := Load(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Port != 9000 {
+		t.Errorf("Expected Consul to override config.yaml, got port %d", cfg.Port)
+	}
+	if cfg.Database.MaxOpenConns != 40 {
+		t.Errorf("Expected dotted keys to be read, got max_open_conns %d", cfg.Database.MaxOpenConns)
+	}
+	if cfg.Database.MaxIdleConns != 2 {
+		t.Errorf("Expected .env.local to override Consul, got max_idle_conns %d", cfg.Database.MaxIdleConns)
+	}
+	if cfg.Log.Level != "error" {
+		t.Errorf("Expected the environment to override Consul, got log.level %q", cfg.Log.Level)
+	}
+	if cfg.Services["recipes"].Host != "recipes" {
+		t.Errorf("Expected service keys to be read, got %+v", cfg.Services)
+	}
+	if got := cfg.ConsulKVPrefix(); got != "hmly/prod/meal/" {
+		t.Errorf("ConsulKVPrefix() = %q", got)
+	}
+}
+
+func TestLoad_ConsulKVDisabled(t *testing.T) {
+	f := newFakeConsul(t, map[string]string{"hmly/dev/gateway/port": "9000"})
+	t.Setenv("CONSUL_KV", "false")
+
+	cfg, err := Load(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Port != 8080 || f.lists != 0 {
+		t.Errorf("Expected Consul to be left alone, got port %d after %d requests", cfg.Port, f.lists)
+	}
+}
+
+func TestLoad_ConsulKVUnreachable(t *testing.T) {
+	t.Setenv("CONSUL_KV", "true")
+	t.Setenv("CONSUL_HOST", "127.0.0.1")
+	t.Setenv("CONSUL_PORT", "1")
+
+	if _, err := Load(Options{}); err == nil || !strings.Contains(err.Error(), "consul kv hmly/dev/gateway/") {
+		t.Errorf("Expected a consul error, got %v", err)
+	}
+}
+
+func TestWatcher_ConsulKV(t *testing.T) {
+	f := newFakeConsul(t, map[string]string{"hmly/dev/gateway/log/level": "info"})
+
+	w, err := NewWatcher(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	changed := make(chan string, 1)
+	w.Subscribe("log.level", func(_, cfg Config) { changed <- cfg.Log.Level })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := w.Start(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Let the watch establish its index before changing the key.
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		f.mu.Lock()
+		lists := f.lists
+		f.mu.Unlock()
+		if lists >= 3 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	f.set("hmly/dev/gateway/log/level", "debug")
+
+	select {
+	case level := <-changed:
+		if level != "debug" {
+			t.Errorf("Expected debug, got %q", level)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected a reload after the key changed")
+	}
+}
@@ -0,0 +1,116 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+	"go.yaml.in/yaml/v3"
+)
+
+// secretKeys are masked in Dump whenever they hold a value. Keys match on
+// their last underscore-separated parts, so auth.clerk_api_key is covered.
+var secretKeys = []string{"api_key", "password", "secret", "token"}
+
+var dsnRules = []logger.RedactRule{
+	{Pattern: logger.DSNPasswordPattern, Replacement: "${1}" + logger.Redacted + "@"},
+	{Pattern: logger.DSNKeywordPasswordPattern, Replacement: "${1}" + logger.Redacted},
+}
+
+// Masked returns the config as the nested map Dump writes: keys as in config
+// files, durations and URLs as strings, Secrets and anything that looks like
+// a secret or a DSN password masked.
+func (c Config) Masked() map[string]any {
+	return settingsMap(reflect.ValueOf(c), true)
+}
+
+// asMap is Masked without the masking, used to compare configs.
+func (c Config) asMap() map[string]any {
+	return settingsMap(reflect.ValueOf(c), false)
+}
+
+// Dump writes the resolved config as YAML with secrets masked, in a form that
+// can be read back as a config file.
+func (c Config) Dump(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.Masked()); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func settingsMap(v reflect.Value, mask bool) map[string]any {
+	out := make(map[string]any)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+		out[key] = settingValue(key, v.Field(i), mask)
+	}
+	return out
+}
+
+func settingValue(key string, v reflect.Value, mask bool) any {
+	switch x := v.Interface().(type) {
+	case Secret:
+		if !mask {
+			return x.Value()
+		}
+		return x.String()
+	case time.Duration:
+		return x.String()
+	case *url.URL:
+		if x == nil {
+			return ""
+		}
+		if !mask {
+			return x.String()
+		}
+		return logger.RedactString(x.String(), dsnRules...)
+	case string:
+		if !mask {
+			return x
+		}
+		if x != "" && isSecretKey(key) {
+			return logger.Redacted
+		}
+		return logger.RedactString(x, dsnRules...)
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		return settingsMap(v, mask)
+	case reflect.Slice:
+		items := make([]any, v.Len())
+		for i := range items {
+			items[i] = settingValue(key, v.Index(i), mask)
+		}
+		return items
+	case reflect.Map:
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[iter.Key().String()] = settingValue(iter.Key().String(), iter.Value(), mask)
+		}
+		return m
+	default:
+		return v.Interface()
+	}
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range secretKeys {
+		if key == secret || strings.HasSuffix(key, "_"+secret) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestConfig_Dump(t *testing.T) {
+	t.Setenv("DSN", "postgres://app:s3cret@db/app")
+	t.Setenv("DB_REPLICA_DSNS", "postgres://app:r3plica@r1/app")
+	t.Setenv("CLERK_API_KEY", "sk_live_1")
+
+	cfg, err := LoadConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := cfg.Dump(&buf); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := buf.String()
+	for _, leaked := range []string{"s3cret", "r3plica", "sk_live_1"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("Expected %q to be masked in\n%s", leaked, out)
+		}
+	}
+	for _, want := range []string{
+		"dsn: '[REDACTED]'",
+		"- '[REDACTED]'",
+		"clerk_api_key: '[REDACTED]'",
+		"statement_timeout: 30s",
+		"clerk_api_url: https://api.clerk.com/v1",
+		"port: 8080",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in\n%s", want, out)
+		}
+	}
+
+	// The dump is itself a valid config file.
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", out)
+	t.Setenv("DSN", "")
+	t.Setenv("DB_REPLICA_DSNS", "")
+	reloaded, err := LoadConfig(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error reloading the dump: %v", err)
+	}
+	if reloaded.Database.StatementTimeout != cfg.Database.StatementTimeout || reloaded.Port != cfg.Port {
+		t.Errorf("Reloaded config differs: %+v", reloaded)
+	}
+}
+
+func TestConfig_MaskedEmptySecrets(t *testing.T) {
+	m := Config{}.Masked()
+	auth := m["auth"].(map[string]any)
+	if auth["clerk_api_key"] != "" {
+		t.Errorf("Expected an empty key to stay empty, got %v", auth["clerk_api_key"])
+	}
+}
@@ -0,0 +1,269 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestLoad_YAML(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", `
+service_name: meal
+port: 9000
+log:
+  format: json
+database:
+  dsn: postgres://u:p@db/app
+  statement_timeout: 2s
+  replica_dsns: [postgres://r1/app, postgres://r2/app]
+consul:
+  port: 8600
+auth:
+  clerk_api_url: http://clerk.test/v1
+services:
+  meal:
+    host: meal-service
+    port: 50051
+    tls: true
+`)
+
+	cfg, err := LoadConfig(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.ServiceName != "meal" || cfg.Port != 9000 || cfg.Log.Format != "json" {
+		t.Errorf("Unexpected top-level settings: %+v", cfg)
+	}
+	if cfg.Database.DSN.Value() != "postgres://u:p@db/app" || cfg.Database.StatementTimeout != 2*time.Second {
+		t.Errorf("Unexpected database settings: %+v", cfg.Database)
+	}
+	if len(cfg.Database.ReplicaDSNs) != 2 {
+		t.Errorf("ReplicaDSNs = %v, want two", cfg.Database.ReplicaDSNs)
+	}
+	if cfg.Database.MaxOpenConns != 25 {
+		t.Errorf("Expected unset keys to keep their defaults, got %d", cfg.Database.MaxOpenConns)
+	}
+	if cfg.Consul.Address() != "consul:8600" {
+		t.Errorf("Consul.Address() = %q", cfg.Consul.Address())
+	}
+	if cfg.Auth.ClerkAPIURL == nil || cfg.Auth.ClerkAPIURL.Host != "clerk.test" {
+		t.Errorf("ClerkAPIURL = %v", cfg.Auth.ClerkAPIURL)
+	}
+	if meal := cfg.Services["meal"]; meal != (ServiceConfig{Host: "meal-service", Port: 50051, TLS: true}) {
+		t.Errorf("Unexpected services: %+v", cfg.Services)
+	}
+}
+
+func TestLoad_TOML(t *testing.T) {
+	path := writeFile(t, t.TempDir(), "service.toml", `
+service_name = "event"
+
+[database]
+max_open_conns = 7
+`)
+
+	cfg, err := Load(Options{Files: []string{path}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.ServiceName != "event" || cfg.Database.MaxOpenConns != 7 {
+		t.Errorf("Unexpected settings: %+v", cfg)
+	}
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "service_name: yaml\nport: 1000\nenv: yaml\nversion: yaml\n")
+	writeFile(t, dir, ".env.local", "SERVICE_NAME=dotenv\nPORT=2000\nENV=dotenv\n")
+	override := writeFile(t, dir, "override.env", "PORT=3000\nENV=override\n")
+	t.Setenv("ENV", "env")
+	t.Setenv("VERSION", "env")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	RegisterFlags(fs)
+	if err := fs.Parse([]string{"-version=flag"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	cfg, err := Load(Options{Paths: []string{dir}, Files: []string{override}, Flags: fs})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.ServiceName != "dotenv" {
+		t.Errorf("ServiceName = %q, want .env.local to win over config.yaml", cfg.ServiceName)
+	}
+	if cfg.Port != 3000 {
+		t.Errorf("Port = %d, want Files to win over Paths", cfg.Port)
+	}
+	if cfg.Env != "env" {
+		t.Errorf("Env = %q, want the environment to win over files", cfg.Env)
+	}
+	if cfg.Version != "flag" {
+		t.Errorf("Version = %q, want flags to win over the environment", cfg.Version)
+	}
+}
+
+func TestLoad_EnvNames(t *testing.T) {
+	t.Setenv("DATABASE_DSN", "postgres://canonical/db")
+	t.Setenv("SERVICES_EVENT_HOST", "events:50051")
+	t.Setenv("EVENT_SERVICE_HOST", "ignored")
+	t.Setenv("CONSUL_PORT", "8501")
+
+	cfg, err := Load(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Database.DSN.Value() != "postgres://canonical/db" {
+		t.Errorf("Database.DSN = %q", cfg.Database.DSN.Value())
+	}
+	if cfg.Services["event"].Host != "events:50051" {
+		t.Errorf("Expected the sectioned name to win over the legacy one, got %q", cfg.Services["event"].Host)
+	}
+	if cfg.Consul.Port != 8501 {
+		t.Errorf("Consul.Port = %d", cfg.Consul.Port)
+	}
+}
+
+func TestLoad_ReportsAllErrors(t *testing.T) {
+	t.Setenv("PORT", "0")
+	t.Setenv("LOG_FORMAT", "xml")
+	t.Setenv("DB_MAX_OPEN_CONNS", "-1")
+	t.Setenv("DB_RETRY_BACKOFF", "-1s")
+	t.Setenv("CONSUL_PORT", "70000")
+	t.Setenv("CLERK_API_URL", "clerk.test")
+	path := writeFile(t, t.TempDir(), "config.yaml", "database:\n  dsn: \"\"\n")
+
+	_, err := Load(Options{Files: []string{path}})
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Expected a ValidationError, got %v", err)
+	}
+	msg := err.Error()
+	for _, want := range []string{"port 0", "log.format", "database.dsn", "database.max_open_conns", "database.retry_backoff", "consul.port", "auth.clerk_api_url"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in %s", want, msg)
+		}
+	}
+	if len(verr.Errs) != 7 {
+		t.Errorf("Expected 7 problems, got %d: %s", len(verr.Errs), msg)
+	}
+}
+
+func TestLoad_DecodeError(t *testing.T) {
+	t.Setenv("PORT", "eighty")
+	if _, err := Load(Options{}); err == nil || !strings.Contains(err.Error(), "port") {
+		t.Errorf("Expected a decode error for port, got %v", err)
+	}
+}
+
+func TestLoad_MissingOrUnsupportedFile(t *testing.T) {
+	if _, err := Load(Options{Files: []string{filepath.Join(t.TempDir(), "missing.yaml")}}); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+	path := writeFile(t, t.TempDir(), "config.ini", "a=b")
+	if _, err := Load(Options{Files: []string{path}}); err == nil {
+		t.Error("Expected an error for an unsupported file type")
+	}
+}
+
+func TestLoad_DoesNotUseGlobalViper(t *testing.T) {
+	viper.Set("service_name", "leaked")
+	t.Cleanup(viper.Reset)
+
+	cfg, err := Load(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.ServiceName != "gateway" {
+		t.Errorf("ServiceName = %q, want the default", cfg.ServiceName)
+	}
+	if viper.IsSet("database.dsn") {
+		t.Error("Expected Load to leave the global viper untouched")
+	}
+}
+
+func TestLoad_Profiles(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.base.yaml", `
+service_name: meal
+database:
+  dsn: postgres://u:p@dev-db/app
+  max_open_conns: 10
+  statement_timeout: 5s
+log:
+  level: debug
+`)
+	writeFile(t, dir, "config.staging.yaml", `
+database:
+  dsn: postgres://u:p@staging-db/app
+log:
+  level: info
+`)
+	writeFile(t, dir, ".env.local", "LOG_LEVEL=warn\n")
+
+	cfg, err := Load(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Env != "dev" || cfg.Database.DSN.Value() != "postgres://u:p@dev-db/app" {
+		t.Errorf("Expected the base profile only for dev, got %q %q", cfg.Env, cfg.Database.DSN.Value())
+	}
+
+	t.Setenv("ENV", "staging")
+	cfg, err = Load(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Database.DSN.Value() != "postgres://u:p@staging-db/app" {
+		t.Errorf("Expected the staging DSN, got %q", cfg.Database.DSN.Value())
+	}
+	if cfg.Database.MaxOpenConns != 10 || cfg.Database.StatementTimeout != 5*time.Second || cfg.ServiceName != "meal" {
+		t.Errorf("Expected keys missing from the profile to be merged from base, got %+v", cfg)
+	}
+	if cfg.Log.Level != "warn" {
+		t.Errorf("Expected .env.local to win over the profile, got %q", cfg.Log.Level)
+	}
+}
+
+func TestLoad_ProfileFromFilesAndOptions(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, ".env.local", "ENV=qa\n")
+	writeFile(t, dir, "config.qa.toml", "port = 7000\n")
+	writeFile(t, dir, "config.test.yaml", "port: 7100\n")
+
+	cfg, err := Load(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Env != "qa" || cfg.Port != 7000 {
+		t.Errorf("Expected the env from .env.local to pick the profile, got %q %d", cfg.Env, cfg.Port)
+	}
+
+	cfg, err = Load(Options{Paths: []string{dir}, Env: "test"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Env != "test" || cfg.Port != 7100 {
+		t.Errorf("Expected Options.Env to pick the profile, got %q %d", cfg.Env, cfg.Port)
+	}
+
+	if _, err := Load(Options{Env: "../etc"}); err == nil {
+		t.Error("Expected an env that is not a plain name to be refused")
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/hmlylab/common/logger"
+)
+
+// Secret holds a sensitive config value. It prints, logs and marshals as
+// [REDACTED]; only Value returns the real thing.
+type Secret struct {
+	value string
+}
+
+func NewSecret(value string) Secret {
+	return Secret{value: value}
+}
+
+func (s Secret) Value() string {
+	return s.value
+}
+
+func (s Secret) IsZero() bool {
+	return s.value == ""
+}
+
+// String returns "" for an empty secret so that "not set" stays visible.
+func (s Secret) String() string {
+	if s.value == "" {
+		return ""
+	}
+	return logger.Redacted
+}
+
+func (s Secret) GoString() string {
+	return fmt.Sprintf("config.Secret(%q)", s.String())
+}
+
+// Format covers every fmt verb, including %#v and %x, which would otherwise
+// reach the value through reflection.
+func (s Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, s.GoString())
+		return
+	}
+	fmt.Fprint(f, s.String())
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Values returns the values of secrets, for APIs that take plain strings.
+func Values(secrets []Secret) []string {
+	out := make([]string, len(secrets))
+	for i, s := range secrets {
+		out[i] = s.value
+	}
+	return out
+}
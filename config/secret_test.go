@@ -0,0 +1,52 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.yaml.in/yaml/v3"
+)
+
+func TestSecret_NeverPrints(t *testing.T) {
+	s := NewSecret("hunter2")
+	if s.Value() != "hunter2" {
+		t.Fatalf("Value() = %q", s.Value())
+	}
+
+	var outputs []string
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d"} {
+		outputs = append(outputs, fmt.Sprintf(verb, s))
+	}
+	outputs = append(outputs, fmt.Sprint(s), fmt.Sprintf("%+v", struct{ Key Secret }{s}))
+
+	j, _ := json.Marshal(map[string]Secret{"key": s})
+	y, _ := yaml.Marshal(map[string]Secret{"key": s})
+	outputs = append(outputs, string(j), string(y))
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("x", "key", s)
+	outputs = append(outputs, buf.String())
+
+	for _, out := range outputs {
+		if strings.Contains(out, "hunter2") {
+			t.Errorf("Secret leaked in %q", out)
+		}
+	}
+	if got := fmt.Sprintf("%#v", s); got != `config.Secret("[REDACTED]")` {
+		t.Errorf("%%#v = %s", got)
+	}
+}
+
+func TestSecret_Empty(t *testing.T) {
+	var s Secret
+	if !s.IsZero() || s.String() != "" {
+		t.Errorf("Expected an empty secret to print empty, got %q", s.String())
+	}
+	if got := Values([]Secret{NewSecret("a"), s}); len(got) != 2 || got[0] != "a" || got[1] != "" {
+		t.Errorf("Values() = %v", got)
+	}
+}
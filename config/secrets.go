@@ -0,0 +1,221 @@
+package config
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/consul/api"
+)
+
+const (
+	secretScheme = "secret://"
+
+	// SecretsKeyEnv holds the base64 AES-256 key for the encrypted secrets
+	// file, and SecretsFileEnv its path.
+	SecretsKeyEnv  = "CONFIG_SECRETS_KEY"
+	SecretsFileEnv = "CONFIG_SECRETS_FILE"
+)
+
+// SecretProvider resolves a reference such as a file path or a Consul KV key
+// to the secret it names.
+type SecretProvider interface {
+	Resolve(ctx context.Context, ref string) (string, error)
+}
+
+type SecretProviderFunc func(ctx context.Context, ref string) (string, error)
+
+func (f SecretProviderFunc) Resolve(ctx context.Context, ref string) (string, error) {
+	return f(ctx, ref)
+}
+
+// secretRefRe matches ${provider:ref} inside a value.
+var secretRefRe = regexp.MustCompile(`\$\{([a-z][a-z0-9_-]*):([^}]*)\}`)
+
+// secretResolver expands secret references in config values. A whole value
+// of secret://<provider>/<ref> is replaced by the secret, and any
+// ${<provider>:<ref>} inside a value is replaced in place, so a password
+// can be spliced into a DSN.
+type secretResolver struct {
+	ctx       context.Context
+	providers map[string]SecretProvider
+}
+
+func (r *secretResolver) resolve(s string) (string, error) {
+	if rest, ok := strings.CutPrefix(s, secretScheme); ok {
+		name, ref, _ := strings.Cut(rest, "/")
+		return r.lookup(name, "/"+ref)
+	}
+	var errs []error
+	out := secretRefRe.ReplaceAllStringFunc(s, func(m string) string {
+		sub := secretRefRe.FindStringSubmatch(m)
+		v, err := r.lookup(sub[1], sub[2])
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return v
+	})
+	return out, errors.Join(errs...)
+}
+
+func (r *secretResolver) lookup(name, ref string) (string, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return "", fmt.Errorf("unknown secret provider %q", name)
+	}
+	v, err := p.Resolve(r.ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("resolving %s secret %s: %w", name, ref, err)
+	}
+	return v, nil
+}
+
+// FileSecretProvider reads a secret from a file, such as a Docker or
+// Kubernetes secret mount, dropping a trailing newline.
+func FileSecretProvider() SecretProvider {
+	return SecretProviderFunc(func(_ context.Context, path string) (string, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(b), "\r\n"), nil
+	})
+}
+
+// ConsulSecretProvider reads a secret from a Consul KV key.
+func ConsulSecretProvider(client *api.Client) SecretProvider {
+	return SecretProviderFunc(func(ctx context.Context, key string) (string, error) {
+		pair, _, err := client.KV().Get(strings.TrimPrefix(key, "/"), (&api.QueryOptions{}).WithContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		if pair == nil {
+			return "", errors.New("key not found")
+		}
+		return string(pair.Value), nil
+	})
+}
+
+// EncryptedFileSecretProvider reads secrets by name from a file written by
+// EncryptSecrets with the same key. The file is decrypted on first use.
+func EncryptedFileSecretProvider(path string, key []byte) SecretProvider {
+	var (
+		once    sync.Once
+		secrets map[string]string
+		loadErr error
+	)
+	return SecretProviderFunc(func(_ context.Context, name string) (string, error) {
+		once.Do(func() {
+			var data []byte
+			if data, loadErr = os.ReadFile(path); loadErr == nil {
+				secrets, loadErr = DecryptSecrets(data, key)
+			}
+		})
+		if loadErr != nil {
+			return "", loadErr
+		}
+		v, ok := secrets[strings.TrimPrefix(name, "/")]
+		if !ok {
+			return "", errors.New("not in the encrypted secrets file")
+		}
+		return v, nil
+	})
+}
+
+// EncryptSecrets seals secrets with AES-256-GCM under key, which must be 32
+// bytes. The result is base64 text, safe to commit next to the config.
+func EncryptSecrets(secrets map[string]string, key []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := gcm.Seal(nonce, nonce, plain, nil)
+	out := make([]byte, base64.StdEncoding.EncodedLen(len(sealed)))
+	base64.StdEncoding.Encode(out, sealed)
+	return out, nil
+}
+
+// DecryptSecrets opens data produced by EncryptSecrets.
+func DecryptSecrets(data, key []byte) (map[string]string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("secrets file is not base64: %w", err)
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("secrets file is truncated")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("secrets file cannot be decrypted with this key")
+	}
+	var secrets map[string]string
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("secrets key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// defaultSecretProviders offers file, consul and, when SecretsKeyEnv is set,
+// encrypted, reading SecretsFileEnv or else the first secrets.enc in paths.
+// The Consul client is only created when a consul reference is resolved,
+// using the consul.host and consul.port being loaded.
+func defaultSecretProviders(paths []string, consulAddress func() string) (map[string]SecretProvider, error) {
+	providers := map[string]SecretProvider{
+		"file": FileSecretProvider(),
+		"consul": SecretProviderFunc(func(ctx context.Context, ref string) (string, error) {
+			client, err := newConsulClient(consulAddress())
+			if err != nil {
+				return "", err
+			}
+			return ConsulSecretProvider(client).Resolve(ctx, ref)
+		}),
+	}
+	if encoded := os.Getenv(SecretsKeyEnv); encoded != "" {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("config: %s is not base64: %w", SecretsKeyEnv, err)
+		}
+		path := os.Getenv(SecretsFileEnv)
+		if path == "" {
+			path = "secrets.enc"
+			if found := findFiles(paths, []string{"secrets.enc"}); len(found) > 0 {
+				path = found[0]
+			}
+		}
+		providers["encrypted"] = EncryptedFileSecretProvider(path, key)
+	}
+	return providers, nil
+}
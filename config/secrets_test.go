@@ -0,0 +1,148 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoad_FileSecrets(t *testing.T) {
+	dir := t.TempDir()
+	key := writeFile(t, dir, "clerk_key", "sk_live_file\n")
+	password := writeFile(t, dir, "db_password", "p@ss")
+	t.Setenv("CLERK_API_KEY", "secret://file"+key)
+	t.Setenv("DSN", "postgres://app:${file:"+password+"}@db/app")
+
+	cfg, err := Load(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Auth.ClerkAPIKey.Value() != "sk_live_file" {
+		t.Errorf("ClerkAPIKey = %q", cfg.Auth.ClerkAPIKey.Value())
+	}
+	if cfg.Database.DSN.Value() != "postgres://app:p@ss@db/app" {
+		t.Errorf("DSN = %q", cfg.Database.DSN.Value())
+	}
+}
+
+func TestLoad_SecretErrors(t *testing.T) {
+	t.Setenv("CLERK_API_KEY", "secret://file"+filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("DSN", "postgres://app:${vault:db}@db/app")
+
+	_, err := Load(Options{})
+	if err == nil {
+		t.Fatal("Expected unresolvable secrets to fail the load")
+	}
+	for _, want := range []string{"resolving file secret", `unknown secret provider "vault"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected %q in %v", want, err)
+		}
+	}
+}
+
+func TestLoad_CustomSecretProvider(t *testing.T) {
+	t.Setenv("DB_REPLICA_DSNS", "secret://vault/r1,postgres://app:${vault:r2-password}@r2/app")
+
+	cfg, err := Load(Options{SecretProviders: map[string]SecretProvider{
+		"vault": SecretProviderFunc(func(_ context.Context, ref string) (string, error) {
+			switch ref {
+			case "/r1":
+				return "postgres://app:one@r1/app", nil
+			case "r2-password":
+				return "two", nil
+			}
+			return "", errors.New("no such secret")
+		}),
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got := Values(cfg.Database.ReplicaDSNs)
+	if len(got) != 2 || got[0] != "postgres://app:one@r1/app" || got[1] != "postgres://app:two@r2/app" {
+		t.Errorf("ReplicaDSNs = %v", got)
+	}
+}
+
+func TestLoad_EncryptedSecrets(t *testing.T) {
+	key := make([]byte, 32)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	sealed, err := EncryptSecrets(map[string]string{"clerk": "sk_live_enc"}, key)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(string(sealed), "sk_live_enc") {
+		t.Fatal("Expected the file to be encrypted")
+	}
+	dir := t.TempDir()
+	writeFile(t, dir, "secrets.enc", string(sealed))
+	writeFile(t, dir, "config.yaml", "auth:\n  clerk_api_key: secret://encrypted/clerk\n")
+	t.Setenv(SecretsKeyEnv, base64.StdEncoding.EncodeToString(key))
+
+	cfg, err := Load(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Auth.ClerkAPIKey.Value() != "sk_live_enc" {
+		t.Errorf("ClerkAPIKey = %q", cfg.Auth.ClerkAPIKey.Value())
+	}
+
+	key[0] ^= 0xff
+	t.Setenv(SecretsKeyEnv, base64.StdEncoding.EncodeToString(key))
+	if _, err := Load(Options{Paths: []string{dir}}); err == nil || !strings.Contains(err.Error(), "cannot be decrypted") {
+		t.Errorf("Expected a decryption error with the wrong key, got %v", err)
+	}
+}
+
+func TestDecryptSecrets_BadInput(t *testing.T) {
+	key := make([]byte, 32)
+	if _, err := DecryptSecrets([]byte("not base64!"), key); err == nil {
+		t.Error("Expected an error for non-base64 input")
+	}
+	if _, err := DecryptSecrets([]byte("AAAA"), key); err == nil {
+		t.Error("Expected an error for truncated input")
+	}
+	if _, err := EncryptSecrets(nil, key[:16]); err == nil {
+		t.Error("Expected an error for a short key")
+	}
+}
+
+func TestLoad_ConsulSecrets(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/kv/hmly/prod/clerk" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode([]map[string]any{{
+			"Key":   "hmly/prod/clerk",
+			"Value": base64.StdEncoding.EncodeToString([]byte("sk_live_consul")),
+		}})
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	t.Setenv("CONSUL_HOST", u.Hostname())
+	t.Setenv("CONSUL_PORT", u.Port())
+	t.Setenv("CLERK_API_KEY", "secret://consul/hmly/prod/clerk")
+
+	cfg, err := Load(Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Auth.ClerkAPIKey.Value() != "sk_live_consul" {
+		t.Errorf("ClerkAPIKey = %q", cfg.Auth.ClerkAPIKey.Value())
+	}
+
+	t.Setenv("CLERK_API_KEY", "${consul:hmly/prod/missing}")
+	if _, err := Load(Options{}); err == nil || !strings.Contains(err.Error(), "key not found") {
+		t.Errorf("Expected a missing key error, got %v", err)
+	}
+}
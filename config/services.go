@@ -0,0 +1,140 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ServiceConfig locates a downstream gRPC service.
+type ServiceConfig struct {
+	Host string `mapstructure:"host"`
+	// Port is optional; without it Host is used as the target as is.
+	Port int  `mapstructure:"port"`
+	TLS  bool `mapstructure:"tls"`
+	// Name is the name the service registers under in Consul. It defaults to
+	// the service's key.
+	Name string `mapstructure:"name"`
+}
+
+// Target returns the gRPC dial target: host:port, or Host alone when no port
+// is set.
+func (s ServiceConfig) Target() string {
+	if s.Port == 0 {
+		return s.Host
+	}
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// ServicesConfig holds the downstream services by name, read from the
+// services section (services.<name>.host) or from SERVICES_<NAME>_HOST,
+// SERVICES_<NAME>_PORT, SERVICES_<NAME>_TLS and SERVICES_<NAME>_NAME. Names
+// are lower case.
+type ServicesConfig map[string]ServiceConfig
+
+// Lookup returns the named service, ignoring case.
+func (s ServicesConfig) Lookup(name string) (ServiceConfig, bool) {
+	svc, ok := s[strings.ToLower(name)]
+	return svc, ok
+}
+
+// Target returns the dial target of the named service, for
+// utils.ConnectToGrpcClient.
+func (s ServicesConfig) Target(name string) (string, error) {
+	svc, ok := s.Lookup(name)
+	if !ok {
+		return "", fmt.Errorf("config: no service %q in services", name)
+	}
+	if svc.Host == "" {
+		return "", fmt.Errorf("config: services.%s.host is not set", strings.ToLower(name))
+	}
+	return svc.Target(), nil
+}
+
+// DiscoveryName returns the Consul service name of the named service, for
+// discovery.GetInstanceWithConsul. Services that are not configured are
+// looked up under their own name.
+func (s ServicesConfig) DiscoveryName(name string) string {
+	if svc, ok := s.Lookup(name); ok && svc.Name != "" {
+		return svc.Name
+	}
+	return strings.ToLower(name)
+}
+
+// Names returns the configured service names in order.
+func (s ServicesConfig) Names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	serviceEnvRe = regexp.MustCompile(`(?i)^SERVICES_([A-Z0-9_]+?)_(HOST|PORT|TLS|NAME)$`)
+	serviceKeyRe = regexp.MustCompile(`(?i)^services[./]([A-Z0-9_-]+)[./](host|port|tls|name)$`)
+
+	// legacyServiceEnv are the host variables used before the services
+	// section existed.
+	legacyServiceEnv = map[string]string{
+		"HOUSEHOLD_SERVICE_HOST": "services.household.host",
+		"MEMBER_SERVICE_HOST":    "services.member.host",
+		"MEAL_SERVICE_HOST":      "services.meal.host",
+		"EVENT_SERVICE_HOST":     "services.event.host",
+	}
+)
+
+// serviceKey maps an env name such as SERVICES_MEAL_PORT, a key such as
+// services.meal.port or services/meal/port, or a legacy env name to the
+// setting key services.meal.port.
+func serviceKey(name string) (string, bool) {
+	if key, ok := legacyServiceEnv[strings.ToUpper(name)]; ok {
+		return key, true
+	}
+	m := serviceEnvRe.FindStringSubmatch(name)
+	if m == nil {
+		m = serviceKeyRe.FindStringSubmatch(name)
+	}
+	if m == nil {
+		return "", false
+	}
+	return "services." + strings.ToLower(m[1]) + "." + strings.ToLower(m[2]), true
+}
+
+// settingKey maps any name accepted by lookupSetting or serviceKey to its
+// setting key.
+func settingKey(name string) (string, bool) {
+	if s, ok := lookupSetting(name); ok {
+		return s.key, true
+	}
+	return serviceKey(name)
+}
+
+// setServiceEnv applies the services environment variables to v. Viper can
+// only bind variables for keys it knows, and service names are open ended.
+// Legacy names are applied first so the sectioned ones win.
+func setServiceEnv(v *viper.Viper) {
+	var legacy, sectioned [][2]string
+	for _, kv := range os.Environ() {
+		name, value, _ := strings.Cut(kv, "=")
+		key, ok := serviceKey(name)
+		if !ok || value == "" {
+			continue
+		}
+		if _, isLegacy := legacyServiceEnv[strings.ToUpper(name)]; isLegacy {
+			legacy = append(legacy, [2]string{key, value})
+		} else {
+			sectioned = append(sectioned, [2]string{key, value})
+		}
+	}
+	for _, kv := range append(legacy, sectioned...) {
+		v.Set(kv[0], kv[1])
+	}
+}
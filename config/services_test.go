@@ -0,0 +1,104 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoad_Services(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", `
+services:
+  meal:
+    host: meal-service
+    port: 50051
+  recipes:
+    host: recipes.internal
+    port: 443
+    tls: true
+`)
+	writeFile(t, dir, ".env.local", "SERVICES_NOTIFY_HOST=notify\nSERVICES_NOTIFY_NAME=notification-service\n")
+	t.Setenv("SERVICES_MEAL_PLANNER_HOST", "planner")
+	t.Setenv("SERVICES_MEAL_PLANNER_PORT", "6000")
+	t.Setenv("SERVICES_RECIPES_TLS", "false")
+	t.Setenv("HOUSEHOLD_SERVICE_HOST", "household:5001")
+
+	cfg, err := LoadConfig(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := ServicesConfig{
+		"meal":         {Host: "meal-service", Port: 50051},
+		"recipes":      {Host: "recipes.internal", Port: 443},
+		"notify":       {Host: "notify", Name: "notification-service"},
+		"meal_planner": {Host: "planner", Port: 6000},
+		"household":    {Host: "household:5001"},
+	}
+	if len(cfg.Services) != len(want) {
+		t.Fatalf("Services = %+v, want %+v", cfg.Services, want)
+	}
+	for name, svc := range want {
+		if cfg.Services[name] != svc {
+			t.Errorf("Services[%q] = %+v, want %+v", name, cfg.Services[name], svc)
+		}
+	}
+}
+
+func TestServicesConfig_Targets(t *testing.T) {
+	services := ServicesConfig{
+		"meal":      {Host: "meal-service", Port: 50051},
+		"household": {Host: "household:5001", Name: "household-service"},
+		"event":     {Port: 5004},
+	}
+
+	for name, want := range map[string]string{"meal": "meal-service:50051", "MEAL": "meal-service:50051", "household": "household:5001"} {
+		got, err := services.Target(name)
+		if err != nil || got != want {
+			t.Errorf("Target(%q) = %q, %v, want %q", name, got, err, want)
+		}
+	}
+	if _, err := services.Target("event"); err == nil || !strings.Contains(err.Error(), "services.event.host") {
+		t.Errorf("Expected a missing host error, got %v", err)
+	}
+	if _, err := services.Target("billing"); err == nil {
+		t.Error("Expected an error for an unknown service")
+	}
+
+	if got := services.DiscoveryName("household"); got != "household-service" {
+		t.Errorf("DiscoveryName(household) = %q", got)
+	}
+	if got := services.DiscoveryName("Billing"); got != "billing" {
+		t.Errorf("DiscoveryName(Billing) = %q", got)
+	}
+	if got := services.Names(); strings.Join(got, ",") != "event,household,meal" {
+		t.Errorf("Names() = %v", got)
+	}
+}
+
+func TestValidate_ServicePort(t *testing.T) {
+	t.Setenv("SERVICES_MEAL_HOST", "meal")
+	t.Setenv("SERVICES_MEAL_PORT", "70000")
+
+	if _, err := Load(Options{}); err == nil || !strings.Contains(err.Error(), "services.meal.port 70000") {
+		t.Errorf("Expected a port error, got %v", err)
+	}
+}
+
+func TestServiceKey(t *testing.T) {
+	for name, want := range map[string]string{
+		"SERVICES_MEAL_HOST":         "services.meal.host",
+		"SERVICES_MEAL_PLANNER_PORT": "services.meal_planner.port",
+		"services.meal.tls":          "services.meal.tls",
+		"services/meal/name":         "services.meal.name",
+		"EVENT_SERVICE_HOST":         "services.event.host",
+	} {
+		if got, ok := serviceKey(name); !ok || got != want {
+			t.Errorf("serviceKey(%q) = %q, %v, want %q", name, got, ok, want)
+		}
+	}
+	for _, name := range []string{"SERVICES_MEAL", "KUBERNETES_SERVICE_HOST", "services.meal"} {
+		if got, ok := serviceKey(name); ok {
+			t.Errorf("serviceKey(%q) = %q, want no match", name, got)
+		}
+	}
+}
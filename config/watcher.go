@@ -0,0 +1,225 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/hmlylab/common/logger"
+)
+
+const defaultDebounce = 100 * time.Millisecond
+
+// Watcher holds the current config and reloads it when its files or, with
+// consul.kv set, its Consul keys change. A reload that fails to load or
+// validate is logged and the previous config is kept. Other sources can
+// trigger a reload with Reload.
+type Watcher struct {
+	opts        Options
+	current     atomic.Pointer[Config]
+	debounce    time.Duration
+	consulRetry time.Duration
+
+	reloadMu sync.Mutex
+	mu       sync.Mutex // guards subs and nextID
+	subs     []*subscription
+	nextID   int
+}
+
+type subscription struct {
+	id   int
+	path string
+	fn   func(old, new Config)
+}
+
+// NewWatcher loads the initial config with opts, failing if it is invalid.
+func NewWatcher(opts Options) (*Watcher, error) {
+	cfg, err := Load(opts)
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{opts: opts, debounce: defaultDebounce, consulRetry: consulRetryDelay}
+	w.current.Store(&cfg)
+	return w, nil
+}
+
+// Current returns the config in effect. It is safe to call from any
+// goroutine, and the returned value never changes.
+func (w *Watcher) Current() Config {
+	return *w.current.Load()
+}
+
+// Subscribe calls fn after a reload that changed the setting or section at
+// path, such as "log.level", "database" or "services.meal". An empty path
+// matches any change. Subscribers run in the order they subscribed, on the
+// reloading goroutine, and must not call Reload. The returned func removes
+// the subscription.
+func (w *Watcher) Subscribe(path string, fn func(old, new Config)) (unsubscribe func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.nextID++
+	id := w.nextID
+	w.subs = append(w.subs, &subscription{id: id, path: path, fn: fn})
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		for i, s := range w.subs {
+			if s.id == id {
+				w.subs = append(w.subs[:i:i], w.subs[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// BindLogLevel keeps the level of the process-wide logger in step with
+// log.level.
+func (w *Watcher) BindLogLevel() (unsubscribe func()) {
+	apply := func(cfg Config) {
+		if lvl, err := logger.ParseLevel(cfg.Log.Level); err == nil {
+			logger.SetLevel(lvl)
+		}
+	}
+	apply(w.Current())
+	return w.Subscribe("log.level", func(_, cfg Config) { apply(cfg) })
+}
+
+// Reload loads the config again and, if it is valid, swaps it in and
+// notifies the subscribers whose paths changed.
+func (w *Watcher) Reload() error {
+	w.reloadMu.Lock()
+	defer w.reloadMu.Unlock()
+
+	cfg, err := Load(w.opts)
+	if err != nil {
+		logger.Default().Error("Config reload failed, keeping the current config", "error", err)
+		return err
+	}
+	old := w.Current()
+	w.current.Store(&cfg)
+
+	oldMap, newMap := old.asMap(), cfg.asMap()
+	if reflect.DeepEqual(oldMap, newMap) {
+		return nil
+	}
+	logger.Default().Info("Config reloaded")
+	w.mu.Lock()
+	subs := append([]*subscription(nil), w.subs...)
+	w.mu.Unlock()
+	for _, s := range subs {
+		if !reflect.DeepEqual(lookupPath(oldMap, s.path), lookupPath(newMap, s.path)) {
+			s.fn(old, cfg)
+		}
+	}
+	return nil
+}
+
+func lookupPath(m map[string]any, path string) any {
+	if path == "" {
+		return m
+	}
+	var v any = m
+	for _, part := range strings.Split(path, ".") {
+		child, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = child[part]
+	}
+	return v
+}
+
+// Start watches the directories in Options.Paths and the Options.Files until
+// ctx is done, reloading shortly after any config file changes. Directories
+// are watched rather than files so that editors and Kubernetes ConfigMaps,
+// which replace files instead of writing them, are picked up. If consul.kv
+// is set when Start is called, the KV prefix is watched too.
+func (w *Watcher) Start(ctx context.Context) error {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	dirs := make(map[string]bool)
+	for _, dir := range w.opts.Paths {
+		dirs[filepath.Clean(dir)] = true
+	}
+	for _, file := range w.opts.Files {
+		dirs[filepath.Dir(file)] = true
+	}
+	for dir := range dirs {
+		if err := fsw.Add(dir); err != nil {
+			fsw.Close()
+			return err
+		}
+	}
+
+	go w.run(ctx, fsw)
+	if w.Current().Consul.KV {
+		go w.watchConsul(ctx)
+	}
+	return nil
+}
+
+func (w *Watcher) run(ctx context.Context, fsw *fsnotify.Watcher) {
+	defer fsw.Close()
+	var timer *time.Timer
+	var fire <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case event, ok := <-fsw.Events:
+			if !ok {
+				return
+			}
+			if !w.isConfigFile(event.Name) || event.Op == fsnotify.Chmod {
+				continue
+			}
+			// Editors often write a file in several steps; reload once
+			// they have settled.
+			if timer == nil {
+				timer = time.NewTimer(w.debounce)
+			} else {
+				timer.Reset(w.debounce)
+			}
+			fire = timer.C
+		case err, ok := <-fsw.Errors:
+			if !ok {
+				return
+			}
+			logger.Default().Warn("Config watch error", "error", err)
+		case <-fire:
+			fire = nil
+			_ = w.Reload()
+		}
+	}
+}
+
+func (w *Watcher) isConfigFile(path string) bool {
+	for _, file := range w.opts.Files {
+		if filepath.Clean(file) == filepath.Clean(path) {
+			return true
+		}
+	}
+	name := filepath.Base(path)
+	if name == ".env.local" || name == "..data" {
+		return true
+	}
+	if !strings.HasPrefix(name, "config.") {
+		return false
+	}
+	for _, ext := range configExts {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
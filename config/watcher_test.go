@@ -0,0 +1,167 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+)
+
+func TestWatcher_ReloadNotifiesChangedPaths(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "log:\n  level: info\nservices:\n  meal:\n    host: meal-a\n")
+
+	w, err := NewWatcher(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var calls []string
+	w.Subscribe("log.level", func(old, new Config) {
+		calls = append(calls, "log.level:"+old.Log.Level+"->"+new.Log.Level)
+	})
+	w.Subscribe("services", func(old, new Config) {
+		calls = append(calls, "services:"+new.Services["meal"].Host)
+	})
+	unsubscribe := w.Subscribe("", func(old, new Config) {
+		calls = append(calls, "any")
+	})
+	w.Subscribe("database", func(old, new Config) {
+		t.Error("Expected no call for an unchanged section")
+	})
+
+	if err := w.Reload(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(calls) != 0 {
+		t.Errorf("Expected no calls when nothing changed, got %v", calls)
+	}
+
+	writeFile(t, dir, "config.yaml", "log:\n  level: debug\nservices:\n  meal:\n    host: meal-b\n")
+	if err := w.Reload(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []string{"log.level:info->debug", "services:meal-b", "any"}
+	if len(calls) != len(want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("calls[%d] = %q, want %q", i, calls[i], want[i])
+		}
+	}
+	if w.Current().Services["meal"].Host != "meal-b" {
+		t.Errorf("Expected the new config to be current, got %q", w.Current().Services["meal"].Host)
+	}
+
+	unsubscribe()
+	calls = nil
+	writeFile(t, dir, "config.yaml", "log:\n  level: debug\nservices:\n  meal:\n    host: meal-c\n")
+	_ = w.Reload()
+	if len(calls) != 1 || calls[0] != "services:meal-c" {
+		t.Errorf("Expected only the services subscriber after unsubscribing, got %v", calls)
+	}
+}
+
+func TestWatcher_InvalidReloadKeepsCurrent(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "port: 9000\n")
+	w, err := NewWatcher(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	w.Subscribe("", func(old, new Config) {
+		t.Error("Expected no notification for an invalid config")
+	})
+
+	writeFile(t, dir, "config.yaml", "port: 0\n")
+	if err := w.Reload(); err == nil {
+		t.Error("Expected the invalid config to be rejected")
+	}
+	if w.Current().Port != 9000 {
+		t.Errorf("Expected the previous config to be kept, got port %d", w.Current().Port)
+	}
+}
+
+func TestNewWatcher_InvalidConfig(t *testing.T) {
+	t.Setenv("PORT", "0")
+	if _, err := NewWatcher(Options{}); err == nil {
+		t.Error("Expected an error for an invalid initial config")
+	}
+}
+
+func TestWatcher_BindLogLevel(t *testing.T) {
+	prev := logger.Level()
+	t.Cleanup(func() { logger.SetLevel(prev) })
+
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "log:\n  level: warn\n")
+	w, err := NewWatcher(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	w.BindLogLevel()
+	if logger.Level() != slog.LevelWarn {
+		t.Errorf("Level = %v, want warn", logger.Level())
+	}
+
+	writeFile(t, dir, "config.yaml", "log:\n  level: debug\n")
+	_ = w.Reload()
+	if logger.Level() != slog.LevelDebug {
+		t.Errorf("Level = %v, want debug", logger.Level())
+	}
+}
+
+func TestWatcher_StartReloadsOnFileChange(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "config.yaml", "services:\n  event:\n    host: event-a\n")
+	w, err := NewWatcher(Options{Paths: []string{dir}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	w.debounce = 10 * time.Millisecond
+
+	var mu sync.Mutex
+	var got []string
+	w.Subscribe("services.event", func(old, new Config) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, new.Services["event"].Host)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := w.Start(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	writeFile(t, dir, "unrelated.txt", "ignored")
+	writeFile(t, dir, "config.yaml", "services:\n  event:\n    host: event-b\n")
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		mu.Lock()
+		n := len(got)
+		mu.Unlock()
+		if n > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected a reload after the file changed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if w.Current().Services["event"].Host != "event-b" {
+		t.Errorf("Services.Event = %q, want event-b", w.Current().Services["event"].Host)
+	}
+}
+
+func TestLookupPath(t *testing.T) {
+	m := map[string]any{"a": map[string]any{"b": 1}}
+	if lookupPath(m, "a.b") != 1 || lookupPath(m, "a.c") != nil || lookupPath(m, "a.b.c") != nil {
+		t.Error("Unexpected lookupPath result")
+	}
+}
@@ -1,24 +1,190 @@
 package database
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/config"
 	"github.com/hmlylab/common/logger"
-	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
 )
 
-func ConnectToDB(dsn string) *gorm.DB {
-	logger := logger.NewLogger()
+var (
+	// openDialector builds the GORM dialector for a DSN. Tests swap it out to
+	// observe connection attempts.
+	openDialector = Dialector
+)
+
+const (
+	// defaultRetryBackoff is the first wait when RetryBackoff is unset, so
+	// retries never run in a tight loop.
+	defaultRetryBackoff = 100 * time.Millisecond
+	maxRetryBackoff     = 30 * time.Second
+)
+
+// Options controls how Open connects and sizes the connection pool.
+// Zero values leave the database/sql defaults in place. StatementTimeout only
+// applies to Postgres, and in-memory SQLite is always limited to a single
+// connection because each connection would otherwise see its own database.
+type Options struct {
+	DSN              string
+	MaxOpenConns     int
+	MaxIdleConns     int
+	ConnMaxLifetime  time.Duration
+	ConnMaxIdleTime  time.Duration
+	StatementTimeout time.Duration
+	ConnectRetries   int
+	RetryBackoff     time.Duration
+	// Logger receives GORM's logs; nil uses NewGormLogger with defaults.
+	Logger gormlogger.Interface
+}
+
+func OptionsFromConfig(cfg config.Config) Options {
+	db := cfg.Database
+	return Options{
+		DSN:              db.DSN.Value(),
+		MaxOpenConns:     db.MaxOpenConns,
+		MaxIdleConns:     db.MaxIdleConns,
+		ConnMaxLifetime:  db.ConnMaxLifetime,
+		ConnMaxIdleTime:  db.ConnMaxIdleTime,
+		StatementTimeout: db.StatementTimeout,
+		ConnectRetries:   db.ConnectRetries,
+		RetryBackoff:     db.RetryBackoff,
+		Logger: NewGormLogger(logger.Default(), GormLoggerConfig{
+			LogLevel:                  ParseGormLogLevel(db.LogLevel),
+			SlowThreshold:             db.SlowQueryThreshold,
+			IgnoreRecordNotFoundError: true,
+			RedactParams:              cfg.IsProduction(),
+		}),
+	}
+}
+
+// Open connects to the database, retrying with exponential backoff, applies
+// the pool settings and pings before returning.
+func Open(ctx context.Context, opts Options) (*gorm.DB, error) {
+	dsn := opts.DSN
+	if DriverFor(dsn) == Postgres {
+		dsn = withStatementTimeout(dsn, opts.StatementTimeout)
+	}
+	if isInMemory(dsn) {
+		opts.MaxOpenConns = 1
+	}
+	backoff := opts.RetryBackoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		var db *gorm.DB
+		db, err = connect(ctx, dsn, opts)
+		if err == nil {
+			logger.Default().Info("Connected to database successfully", "driver", DriverFor(dsn))
+			return db, nil
+		}
+		if attempt >= opts.ConnectRetries {
+			break
+		}
+
+		logger.Default().Warn("Failed to connect to database, retrying", "attempt", attempt+1, "backoff", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			logger.Default().Error("Failed to connect to database", "error", ctx.Err())
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
 
-	DB, err := gorm.Open(postgres.New(postgres.Config{
-		DSN: dsn,
-	}), &gorm.Config{
+	logger.Default().Error("Failed to connect to database", "error", err)
+	return nil, err
+}
+
+func connect(ctx context.Context, dsn string, opts Options) (*gorm.DB, error) {
+	gormLog := opts.Logger
+	if gormLog == nil {
+		gormLog = NewGormLogger(logger.Default(), GormLoggerConfig{
+			LogLevel:                  gormlogger.Warn,
+			SlowThreshold:             defaultSlowThreshold,
+			IgnoreRecordNotFoundError: true,
+		})
+	}
+	db, err := gorm.Open(openDialector(dsn), &gorm.Config{
 		PrepareStmt: false,
+		Logger:      gormLog,
 	})
+	if err != nil {
+		return nil, err
+	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if opts.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(opts.MaxOpenConns)
+	}
+	if opts.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(opts.MaxIdleConns)
+	}
+	if opts.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(opts.ConnMaxLifetime)
+	}
+	if opts.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(opts.ConnMaxIdleTime)
+	}
+
+	if err := sqlDB.PingContext(ctx); err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// withStatementTimeout passes statement_timeout to Postgres as a runtime
+// parameter, for both URL and keyword/value DSNs.
+func withStatementTimeout(dsn string, timeout time.Duration) string {
+	if timeout <= 0 {
+		return dsn
+	}
+	ms := fmt.Sprintf("%d", timeout.Milliseconds())
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return dsn
+		}
+		q := u.Query()
+		q.Set("statement_timeout", ms)
+		u.RawQuery = q.Encode()
+		return u.String()
+	}
+	return strings.TrimSpace(dsn + " statement_timeout=" + ms)
+}
+
+// Close releases the connection pool behind db.
+func Close(db *gorm.DB) error {
+	if db == nil {
+		return nil
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// ConnectToDB opens a connection with default pool settings and no retries.
+//
+// Deprecated: use Open, which reports the connection error instead of
+// returning nil.
+func ConnectToDB(dsn string) *gorm.DB {
+	db, err := Open(context.Background(), Options{DSN: dsn})
 	if err != nil {
-		logger.Error("Failed to connect to database", "error", err)
 		return nil
 	}
-	logger.Info("Connected to database successfully")
-	return DB
+	return db
 }
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"strings"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type Driver string
+
+const (
+	Postgres Driver = "postgres"
+	SQLite   Driver = "sqlite"
+)
+
+const sqliteScheme = "sqlite://"
+
+// DriverFor picks the driver from the DSN: sqlite://, file: and :memory: go to
+// SQLite, everything else (URLs or keyword/value strings) to Postgres.
+func DriverFor(dsn string) Driver {
+	switch {
+	case strings.HasPrefix(dsn, sqliteScheme),
+		strings.HasPrefix(dsn, "file:"),
+		strings.HasPrefix(dsn, ":memory:"):
+		return SQLite
+	default:
+		return Postgres
+	}
+}
+
+// Dialector returns the GORM dialector for the DSN's driver.
+func Dialector(dsn string) gorm.Dialector {
+	if DriverFor(dsn) == SQLite {
+		return sqlite.Open(sqliteDSN(dsn))
+	}
+	return postgres.New(postgres.Config{DSN: dsn})
+}
+
+// sqliteDSN strips the sqlite:// scheme, leaving a path (or :memory:) that the
+// sqlite3 driver understands. sqlite:///var/db.sqlite keeps its absolute path.
+func sqliteDSN(dsn string) string {
+	return strings.TrimPrefix(dsn, sqliteScheme)
+}
+
+// isInMemory reports whether every pooled connection would get its own
+// private SQLite database.
+func isInMemory(dsn string) bool {
+	if DriverFor(dsn) != SQLite {
+		return false
+	}
+	path := strings.TrimPrefix(sqliteDSN(dsn), "file:")
+	inMemory := strings.HasPrefix(path, ":memory:") || strings.Contains(path, "mode=memory")
+	return inMemory && !strings.Contains(path, "cache=shared")
+}
@@ -0,0 +1,117 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/utils"
+)
+
+const defaultSlowThreshold = 200 * time.Millisecond
+
+type GormLoggerConfig struct {
+	LogLevel                  gormlogger.LogLevel
+	SlowThreshold             time.Duration
+	IgnoreRecordNotFoundError bool
+	// RedactParams logs statements with placeholders instead of bound values.
+	RedactParams bool
+}
+
+// gormLogger sends GORM's query and error logs through slog.
+type gormLogger struct {
+	log *slog.Logger
+	cfg GormLoggerConfig
+}
+
+// NewGormLogger logs through l, adding the correlation IDs of the query's
+// context even when l was not built by logger.New.
+func NewGormLogger(l *slog.Logger, cfg GormLoggerConfig) gormlogger.Interface {
+	return &gormLogger{log: slog.New(logger.NewContextHandler(l.Handler())), cfg: cfg}
+}
+
+// ParseGormLogLevel maps silent, error, warn and info to GORM's levels,
+// defaulting to warn.
+func ParseGormLogLevel(level string) gormlogger.LogLevel {
+	switch strings.ToLower(level) {
+	case "silent":
+		return gormlogger.Silent
+	case "error":
+		return gormlogger.Error
+	case "info":
+		return gormlogger.Info
+	default:
+		return gormlogger.Warn
+	}
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.cfg.LogLevel = level
+	return &clone
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.cfg.LogLevel >= gormlogger.Info {
+		l.log.InfoContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.cfg.LogLevel >= gormlogger.Warn {
+		l.log.WarnContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.cfg.LogLevel >= gormlogger.Error {
+		l.log.ErrorContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.cfg.LogLevel <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.cfg.LogLevel >= gormlogger.Error &&
+		(!errors.Is(err, gorm.ErrRecordNotFound) || !l.cfg.IgnoreRecordNotFoundError):
+		sql, rows := fc()
+		l.log.ErrorContext(ctx, "Query failed", append(l.queryAttrs(sql, rows, elapsed), "error", err)...)
+	case l.cfg.SlowThreshold > 0 && elapsed > l.cfg.SlowThreshold && l.cfg.LogLevel >= gormlogger.Warn:
+		sql, rows := fc()
+		l.log.WarnContext(ctx, "Slow query", append(l.queryAttrs(sql, rows, elapsed), "slow_threshold", l.cfg.SlowThreshold)...)
+	case l.cfg.LogLevel >= gormlogger.Info:
+		sql, rows := fc()
+		l.log.InfoContext(ctx, "Query", l.queryAttrs(sql, rows, elapsed)...)
+	}
+}
+
+// ParamsFilter is called by GORM before it interpolates bound values into the
+// statement it hands to Trace.
+func (l *gormLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	if l.cfg.RedactParams {
+		return sql, nil
+	}
+	return sql, params
+}
+
+func (l *gormLogger) queryAttrs(sql string, rows int64, elapsed time.Duration) []any {
+	attrs := []any{
+		"sql", sql,
+		"duration", elapsed,
+		"caller", utils.FileWithLineNum(),
+	}
+	if rows >= 0 {
+		attrs = append(attrs, "rows", rows)
+	}
+	return attrs
+}
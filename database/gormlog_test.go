@@ -0,0 +1,113 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+type secret struct {
+	ID    string `gorm:"primaryKey"`
+	Email string
+}
+
+func openLogged(t *testing.T, cfg GormLoggerConfig) (*gorm.DB, *bytes.Buffer) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	db, err := Open(context.Background(), Options{DSN: ":memory:", Logger: NewGormLogger(l, cfg)})
+	assert.NoError(t, err)
+	t.Cleanup(func() { Close(db) })
+	assert.NoError(t, db.AutoMigrate(&secret{}))
+	buf.Reset()
+	return db, &buf
+}
+
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestGormLogger_TraceInfo(t *testing.T) {
+	db, buf := openLogged(t, GormLoggerConfig{LogLevel: gormlogger.Info})
+	ctx := logger.WithRequestID(context.Background(), "req-123")
+
+	assert.NoError(t, db.WithContext(ctx).Create(&secret{ID: "1", Email: "jane@example.com"}).Error)
+
+	lines := logLines(t, buf)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "Query", lines[0]["msg"])
+	assert.Equal(t, "INFO", lines[0]["level"])
+	assert.Equal(t, "req-123", lines[0]["request_id"])
+	assert.Equal(t, float64(1), lines[0]["rows"])
+	assert.Contains(t, lines[0]["sql"], "jane@example.com")
+	assert.Contains(t, lines[0], "duration")
+}
+
+func TestGormLogger_RedactParams(t *testing.T) {
+	db, buf := openLogged(t, GormLoggerConfig{LogLevel: gormlogger.Info, RedactParams: true})
+
+	assert.NoError(t, db.Create(&secret{ID: "1", Email: "jane@example.com"}).Error)
+
+	lines := logLines(t, buf)
+	assert.Len(t, lines, 1)
+	assert.NotContains(t, lines[0]["sql"], "jane@example.com")
+	assert.Contains(t, lines[0]["sql"], "?")
+}
+
+func TestGormLogger_SlowQuery(t *testing.T) {
+	db, buf := openLogged(t, GormLoggerConfig{LogLevel: gormlogger.Warn, SlowThreshold: time.Nanosecond})
+
+	var s []secret
+	assert.NoError(t, db.Find(&s).Error)
+
+	lines := logLines(t, buf)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "Slow query", lines[0]["msg"])
+	assert.Equal(t, "WARN", lines[0]["level"])
+}
+
+func TestGormLogger_Errors(t *testing.T) {
+	db, buf := openLogged(t, GormLoggerConfig{LogLevel: gormlogger.Warn, IgnoreRecordNotFoundError: true})
+
+	var s secret
+	assert.ErrorIs(t, db.First(&s, "id = ?", "missing").Error, gorm.ErrRecordNotFound)
+	assert.Empty(t, buf.String(), "record not found should be ignored")
+
+	assert.Error(t, db.Exec("SELECT * FROM missing_table").Error)
+	lines := logLines(t, buf)
+	assert.Len(t, lines, 1)
+	assert.Equal(t, "Query failed", lines[0]["msg"])
+	assert.Contains(t, lines[0]["error"], "missing_table")
+}
+
+func TestGormLogger_Silent(t *testing.T) {
+	db, buf := openLogged(t, GormLoggerConfig{LogLevel: gormlogger.Info})
+
+	assert.Error(t, db.Session(&gorm.Session{Logger: db.Logger.LogMode(gormlogger.Silent)}).Exec("SELECT * FROM missing_table").Error)
+	assert.Empty(t, buf.String())
+}
+
+func TestParseGormLogLevel(t *testing.T) {
+	assert.Equal(t, gormlogger.Silent, ParseGormLogLevel("silent"))
+	assert.Equal(t, gormlogger.Error, ParseGormLogLevel("ERROR"))
+	assert.Equal(t, gormlogger.Info, ParseGormLogLevel("info"))
+	assert.Equal(t, gormlogger.Warn, ParseGormLogLevel("warn"))
+	assert.Equal(t, gormlogger.Warn, ParseGormLogLevel("bogus"))
+}
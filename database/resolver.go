@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+)
+
+type ctxKey int
+
+const (
+	primaryKey ctxKey = iota
+	txKey
+)
+
+// WithPrimary marks ctx so reads go to the primary, e.g. straight after a
+// write that replicas may not have caught up with yet.
+func WithPrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey, true)
+}
+
+func forcePrimary(ctx context.Context) bool {
+	force, _ := ctx.Value(primaryKey).(bool)
+	return force
+}
+
+func txFrom(ctx context.Context) *gorm.DB {
+	tx, _ := ctx.Value(txKey).(*gorm.DB)
+	return tx
+}
+
+type replica struct {
+	dsn     string
+	mu      sync.Mutex
+	db      *gorm.DB
+	healthy atomic.Bool
+}
+
+func (r *replica) conn() *gorm.DB {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.db
+}
+
+// Resolver routes reads to healthy replicas in round-robin order and
+// everything else to the primary.
+type Resolver struct {
+	primary  *gorm.DB
+	replicas []*replica
+	next     atomic.Uint64
+	opts     Options
+}
+
+// NewResolver wraps already-open connections. With no replicas every call
+// resolves to the primary.
+func NewResolver(primary *gorm.DB, replicas ...*gorm.DB) *Resolver {
+	r := &Resolver{primary: primary}
+	for _, db := range replicas {
+		rep := &replica{db: db}
+		rep.healthy.Store(true)
+		r.replicas = append(r.replicas, rep)
+	}
+	return r
+}
+
+// OpenResolver connects to the primary with opts and to each replica DSN with
+// the same pool settings. The primary is required; a replica that can't be
+// reached is left unhealthy and retried by CheckHealth.
+func OpenResolver(ctx context.Context, opts Options, replicaDSNs []string) (*Resolver, error) {
+	primary, err := Open(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	r := &Resolver{primary: primary, opts: opts}
+	for _, dsn := range replicaDSNs {
+		rep := &replica{dsn: dsn}
+		replicaOpts := opts
+		replicaOpts.DSN = dsn
+		replicaOpts.ConnectRetries = 0
+		if db, err := Open(ctx, replicaOpts); err != nil {
+			logger.Default().WarnContext(ctx, "Read replica unavailable, reads will fall back to primary", "error", err)
+		} else {
+			rep.db = db
+			rep.healthy.Store(true)
+		}
+		r.replicas = append(r.replicas, rep)
+	}
+	return r, nil
+}
+
+func (r *Resolver) Primary() *gorm.DB {
+	return r.primary
+}
+
+// Writer returns the transaction carried by ctx, or the primary.
+func (r *Resolver) Writer(ctx context.Context) *gorm.DB {
+	if tx := txFrom(ctx); tx != nil {
+		return tx
+	}
+	return r.primary.WithContext(ctx)
+}
+
+// Reader returns the connection a read should use: the transaction or the
+// primary when ctx asks for it, otherwise the next healthy replica.
+func (r *Resolver) Reader(ctx context.Context) *gorm.DB {
+	if tx := txFrom(ctx); tx != nil {
+		return tx
+	}
+	if forcePrimary(ctx) {
+		return r.primary.WithContext(ctx)
+	}
+	if rep := r.pick(); rep != nil {
+		return rep.conn().WithContext(ctx)
+	}
+	return r.primary.WithContext(ctx)
+}
+
+func (r *Resolver) pick() *replica {
+	n := len(r.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := 0; i < n; i++ {
+		rep := r.replicas[(start+uint64(i))%uint64(n)]
+		if rep.healthy.Load() && rep.conn() != nil {
+			return rep
+		}
+	}
+	return nil
+}
+
+// Read runs fn against Reader(ctx). If a replica fails with anything other
+// than ErrRecordNotFound and no longer answers a ping, it is marked unhealthy
+// and fn is retried on the primary.
+func (r *Resolver) Read(ctx context.Context, fn func(db *gorm.DB) error) error {
+	if txFrom(ctx) != nil || forcePrimary(ctx) {
+		return fn(r.Reader(ctx))
+	}
+	rep := r.pick()
+	if rep == nil {
+		return fn(r.primary.WithContext(ctx))
+	}
+
+	err := fn(rep.conn().WithContext(ctx))
+	if err == nil || errors.Is(err, gorm.ErrRecordNotFound) || ctx.Err() != nil {
+		return err
+	}
+	if pingErr := ping(ctx, rep.conn()); pingErr == nil {
+		return err
+	}
+	rep.healthy.Store(false)
+	logger.Default().WarnContext(ctx, "Read replica failed, falling back to primary", "error", err)
+	return fn(r.primary.WithContext(ctx))
+}
+
+// Transaction runs fn in a primary transaction. The ctx passed to fn carries
+// the transaction, so Reader and Writer resolve to it.
+func (r *Resolver) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx := txFrom(ctx); tx != nil {
+		return fn(ctx)
+	}
+	return r.primary.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey, tx))
+	})
+}
+
+// CheckHealth pings every replica, reconnecting ones that failed to open, and
+// updates which of them receive reads.
+func (r *Resolver) CheckHealth(ctx context.Context) {
+	for _, rep := range r.replicas {
+		db := rep.conn()
+		if db == nil && rep.dsn != "" {
+			replicaOpts := r.opts
+			replicaOpts.DSN = rep.dsn
+			replicaOpts.ConnectRetries = 0
+			if opened, err := Open(ctx, replicaOpts); err == nil {
+				rep.mu.Lock()
+				rep.db = opened
+				rep.mu.Unlock()
+				db = opened
+			}
+		}
+		healthy := db != nil && ping(ctx, db) == nil
+		if was := rep.healthy.Swap(healthy); was != healthy {
+			logger.Default().InfoContext(ctx, "Read replica health changed", "healthy", healthy)
+		}
+	}
+}
+
+// StartHealthChecks runs CheckHealth every interval until ctx is done.
+func (r *Resolver) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	if len(r.replicas) == 0 || interval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+// Close releases the primary and every replica pool.
+func (r *Resolver) Close() error {
+	errs := []error{Close(r.primary)}
+	for _, rep := range r.replicas {
+		errs = append(errs, Close(rep.conn()))
+	}
+	return errors.Join(errs...)
+}
+
+func ping(ctx context.Context, db *gorm.DB) error {
+	if db == nil {
+		return errors.New("database: not connected")
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+type node struct {
+	ID   string `gorm:"primaryKey"`
+	Name string
+}
+
+func openNode(t *testing.T, name string) *gorm.DB {
+	db, err := Open(context.Background(), Options{DSN: "sqlite://" + filepath.Join(t.TempDir(), name+".db")})
+	assert.NoError(t, err)
+	t.Cleanup(func() { Close(db) })
+	assert.NoError(t, db.AutoMigrate(&node{}))
+	assert.NoError(t, db.Create(&node{ID: "1", Name: name}).Error)
+	return db
+}
+
+func readName(t *testing.T, r *Resolver, ctx context.Context) string {
+	var n node
+	assert.NoError(t, r.Read(ctx, func(db *gorm.DB) error {
+		return db.First(&n, "id = ?", "1").Error
+	}))
+	return n.Name
+}
+
+func TestResolver_NoReplicasUsesPrimary(t *testing.T) {
+	r := NewResolver(openNode(t, "primary"))
+	assert.Equal(t, "primary", readName(t, r, context.Background()))
+}
+
+func TestResolver_RoundRobin(t *testing.T) {
+	r := NewResolver(openNode(t, "primary"), openNode(t, "replica-a"), openNode(t, "replica-b"))
+	ctx := context.Background()
+
+	seen := map[string]int{}
+	for i := 0; i < 4; i++ {
+		seen[readName(t, r, ctx)]++
+	}
+	assert.Equal(t, map[string]int{"replica-a": 2, "replica-b": 2}, seen)
+}
+
+func TestResolver_WithPrimary(t *testing.T) {
+	r := NewResolver(openNode(t, "primary"), openNode(t, "replica"))
+	assert.Equal(t, "primary", readName(t, r, WithPrimary(context.Background())))
+}
+
+func TestResolver_WritesGoToPrimary(t *testing.T) {
+	primary := openNode(t, "primary")
+	r := NewResolver(primary, openNode(t, "replica"))
+
+	assert.NoError(t, r.Writer(context.Background()).Create(&node{ID: "2", Name: "new"}).Error)
+
+	var count int64
+	assert.NoError(t, primary.Model(&node{}).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestResolver_TransactionReadsStickToPrimary(t *testing.T) {
+	r := NewResolver(openNode(t, "primary"), openNode(t, "replica"))
+
+	sentinel := errors.New("rollback")
+	err := r.Transaction(context.Background(), func(ctx context.Context) error {
+		assert.NoError(t, r.Writer(ctx).Model(&node{}).Where("id = ?", "1").Update("name", "in-tx").Error)
+		assert.Equal(t, "in-tx", readName(t, r, ctx))
+		return sentinel
+	})
+	assert.ErrorIs(t, err, sentinel)
+	assert.Equal(t, "primary", readName(t, r, WithPrimary(context.Background())))
+}
+
+func TestResolver_FallsBackWhenReplicaDown(t *testing.T) {
+	replica := openNode(t, "replica")
+	r := NewResolver(openNode(t, "primary"), replica)
+	ctx := context.Background()
+
+	assert.Equal(t, "replica", readName(t, r, ctx))
+
+	assert.NoError(t, Close(replica))
+	assert.Equal(t, "primary", readName(t, r, ctx), "should retry on primary")
+	assert.False(t, r.replicas[0].healthy.Load())
+	assert.Equal(t, "primary", readName(t, r, ctx), "unhealthy replica should be skipped")
+}
+
+func TestResolver_NotFoundDoesNotFallBack(t *testing.T) {
+	r := NewResolver(openNode(t, "primary"), openNode(t, "replica"))
+
+	err := r.Read(context.Background(), func(db *gorm.DB) error {
+		var n node
+		return db.First(&n, "id = ?", "missing").Error
+	})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.True(t, r.replicas[0].healthy.Load())
+}
+
+func TestResolver_CheckHealth(t *testing.T) {
+	replica := openNode(t, "replica")
+	r := NewResolver(openNode(t, "primary"), replica)
+	ctx := context.Background()
+
+	r.CheckHealth(ctx)
+	assert.True(t, r.replicas[0].healthy.Load())
+
+	assert.NoError(t, Close(replica))
+	r.CheckHealth(ctx)
+	assert.False(t, r.replicas[0].healthy.Load())
+}
+
+func TestOpenResolver_UnreachableReplica(t *testing.T) {
+	dir := t.TempDir()
+	r, err := OpenResolver(context.Background(),
+		Options{DSN: "sqlite://" + filepath.Join(dir, "primary.db")},
+		[]string{"file:" + filepath.Join(dir, "missing", "replica.db") + "?mode=ro"},
+	)
+	assert.NoError(t, err)
+	defer r.Close()
+
+	assert.Len(t, r.replicas, 1)
+	assert.False(t, r.replicas[0].healthy.Load())
+
+	assert.NoError(t, r.Primary().AutoMigrate(&node{}))
+	assert.NoError(t, r.Primary().Create(&node{ID: "1", Name: "primary"}).Error)
+	assert.Equal(t, "primary", readName(t, r, context.Background()), "reads fall back to primary")
+}
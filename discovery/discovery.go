@@ -9,14 +9,10 @@ import (
 	"github.com/hmlylab/common/logger"
 )
 
-var (
-	log = logger.NewLogger()
-)
-
 func parsePort(portStr string) int {
 	port, err := strconv.Atoi(portStr)
 	if err != nil {
-		log.Error("Could not parse port: " + err.Error())
+		logger.Default().Error("Could not parse port: " + err.Error())
 		return 0
 	}
 	return port
@@ -27,7 +23,7 @@ func RegisterServiceWithConsul(serviceName, servicePort, consulHost, consulPort
 	consulConfig.Address = resolveConsulAddress(consulPort, consulHost)
 	client, err := consulapi.NewClient(consulConfig)
 	if err != nil {
-		log.Error("Failed to create Consul client: " + err.Error())
+		logger.Default().Error("Failed to create Consul client: " + err.Error())
 		os.Exit(1)
 	}
 
@@ -45,10 +41,10 @@ func RegisterServiceWithConsul(serviceName, servicePort, consulHost, consulPort
 	}
 
 	if err := client.Agent().ServiceRegister(registration); err != nil {
-		log.Error("Failed to register service with Consul: " + err.Error())
+		logger.Default().Error("Failed to register service with Consul: " + err.Error())
 		os.Exit(1) // Exit if we can't register the service
 	}
-	log.Info("Service registered with Consul: " + registration.Name)
+	logger.Default().Info("Service registered with Consul: " + registration.Name)
 }
 
 func DeregisterServiceWithConsul(serviceName, consulHost, consulPort string) {
@@ -56,15 +52,15 @@ func DeregisterServiceWithConsul(serviceName, consulHost, consulPort string) {
 	consulConfig.Address = resolveConsulAddress(consulPort, consulHost)
 	client, err := consulapi.NewClient(consulConfig)
 	if err != nil {
-		log.Error("Failed to create Consul client: " + err.Error())
+		logger.Default().Error("Failed to create Consul client: " + err.Error())
 		os.Exit(1)
 	}
 
 	if err := client.Agent().ServiceDeregister(serviceName); err != nil {
-		log.Error("Failed to deregister service from Consul: " + err.Error())
+		logger.Default().Error("Failed to deregister service from Consul: " + err.Error())
 		os.Exit(1)
 	}
-	log.Info("Service deregistered from Consul: " + serviceName)
+	logger.Default().Info("Service deregistered from Consul: " + serviceName)
 }
 
 func GetInstanceWithConsul(serviceName, consulHost, consulPort string) *consulapi.ServiceEntry {
@@ -72,18 +68,18 @@ func GetInstanceWithConsul(serviceName, consulHost, consulPort string) *consulap
 	consulConfig.Address = resolveConsulAddress(consulPort, consulHost)
 	client, err := consulapi.NewClient(consulConfig)
 	if err != nil {
-		log.Error("Failed to create Consul client: " + err.Error())
+		logger.Default().Error("Failed to create Consul client: " + err.Error())
 		os.Exit(1)
 	}
 
 	instances, _, err := client.Health().Service(serviceName, "", true, nil)
 	if err != nil {
-		log.Error("Failed to get service from Consul: " + err.Error())
+		logger.Default().Error("Failed to get service from Consul: " + err.Error())
 		os.Exit(1)
 	}
 
 	if len(instances) == 0 {
-		log.Error("No service found with name: " + serviceName)
+		logger.Default().Error("No service found with name: " + serviceName)
 		os.Exit(1)
 	}
 
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AllDayDate returns the date of t, in t's location, as stored for all-day
+// events: midnight UTC.
+func AllDayDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// In returns e as seen from loc. A timed event's times move to loc, so a
+// series repeats at the same wall clock time there across DST changes. An
+// all-day event's dates, ExDates and RecurrenceID move from midnight UTC to
+// midnight in loc, so it covers whole local days.
+func (e Event) In(loc *time.Location) Event {
+	if loc == nil {
+		return e
+	}
+	move := func(t time.Time) time.Time { return t.In(loc) }
+	if e.AllDay {
+		move = func(t time.Time) time.Time { return localMidnight(t, loc) }
+	}
+	return e.mapTimes(move)
+}
+
+// Validate checks what every event needs: a start date, an end date that is
+// not before it, and a valid RRule if it recurs.
+func (e Event) Validate() error {
+	if e.StartDate.IsZero() {
+		return fmt.Errorf("%w: start_date is required", ErrInvalidArgument)
+	}
+	if e.EndDate.Before(e.StartDate) {
+		return fmt.Errorf("%w: end_date is before start_date", ErrInvalidArgument)
+	}
+	if e.IsRecurring() {
+		if _, err := e.Recurrence(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeSave stores an event's times in UTC, so they compare correctly in
+// queries whatever zone they were given in. All-day dates keep their date, so
+// an event returned by In is stored as it was.
+func (e *Event) BeforeSave(tx *gorm.DB) error {
+	if e.AllDay {
+		*e = e.mapTimes(AllDayDate)
+	} else {
+		*e = e.mapTimes(time.Time.UTC)
+	}
+	return nil
+}
+
+// mapTimes returns e with f applied to its dates, ExDates and RecurrenceID.
+func (e Event) mapTimes(f func(time.Time) time.Time) Event {
+	e.StartDate, e.EndDate = f(e.StartDate), f(e.EndDate)
+	if e.ExDates != nil {
+		exDates := make(TimeList, len(e.ExDates))
+		for i, t := range e.ExDates {
+			exDates[i] = f(t)
+		}
+		e.ExDates = exDates
+	}
+	if e.RecurrenceID != nil {
+		rid := f(*e.RecurrenceID)
+		e.RecurrenceID = &rid
+	}
+	return e
+}
+
+func localMidnight(t time.Time, loc *time.Location) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
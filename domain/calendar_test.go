@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAllDayDate(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	got := AllDayDate(time.Date(2025, 5, 1, 1, 30, 0, 0, tokyo))
+	if !got.Equal(date(2025, 5, 1, 0)) {
+		t.Errorf("AllDayDate() = %v, want 2025-05-01 UTC", got)
+	}
+}
+
+func TestEvent_In(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	rid := date(2025, 5, 8, 0)
+	e := Event{
+		AllDay:    true,
+		StartDate: date(2025, 5, 1, 0), EndDate: date(2025, 5, 2, 0),
+		RRule: "FREQ=WEEKLY", ExDates: TimeList{date(2025, 5, 15, 0)}, RecurrenceID: &rid,
+	}
+
+	local := e.In(tokyo)
+	if !local.StartDate.Equal(time.Date(2025, 5, 1, 0, 0, 0, 0, tokyo)) || !local.EndDate.Equal(time.Date(2025, 5, 2, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Dates = %v - %v, want 1 May in Tokyo", local.StartDate, local.EndDate)
+	}
+	if !local.ExDates[0].Equal(time.Date(2025, 5, 15, 0, 0, 0, 0, tokyo)) || !local.RecurrenceID.Equal(time.Date(2025, 5, 8, 0, 0, 0, 0, tokyo)) {
+		t.Errorf("Expected ExDates and RecurrenceID to move too, got %v and %v", local.ExDates, local.RecurrenceID)
+	}
+	if !e.ExDates[0].Equal(date(2025, 5, 15, 0)) || !e.RecurrenceID.Equal(rid) {
+		t.Error("Expected the original event to be left alone")
+	}
+
+	// The cancelled occurrence stays cancelled in the local zone.
+	occurrences, err := local.Occurrences(time.Date(2025, 5, 1, 0, 0, 0, 0, tokyo), time.Date(2025, 5, 22, 0, 0, 0, 0, tokyo))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(occurrences) != 2 {
+		t.Errorf("Expected 1 and 8 May, got %d occurrences", len(occurrences))
+	}
+
+	timed := Event{StartDate: date(2025, 5, 1, 9), EndDate: date(2025, 5, 1, 10)}
+	if got := timed.In(tokyo); !got.StartDate.Equal(timed.StartDate) || got.StartDate.Location() != tokyo {
+		t.Errorf("Expected a timed event to keep its instant in Tokyo, got %v", got.StartDate)
+	}
+}
+
+func TestEvent_InAcrossDST(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	// Dinner at 6pm on Fridays, stored in UTC during winter time.
+	dinner := Event{StartDate: date(2025, 3, 21, 18), EndDate: date(2025, 3, 21, 19), RRule: "FREQ=WEEKLY"}
+
+	occurrences, err := dinner.In(london).Occurrences(date(2025, 3, 21, 0), date(2025, 4, 5, 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(occurrences) != 3 {
+		t.Fatalf("Expected 3 occurrences, got %d", len(occurrences))
+	}
+	for _, o := range occurrences {
+		if local := o.Event.StartDate.In(london); local.Hour() != 18 {
+			t.Errorf("Expected dinner at 6pm, got %v", local)
+		}
+	}
+}
+
+func TestEvent_BeforeSave(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	rid := time.Date(2025, 5, 8, 9, 0, 0, 0, tokyo)
+	e := Event{
+		StartDate: time.Date(2025, 5, 1, 9, 0, 0, 0, tokyo), EndDate: time.Date(2025, 5, 1, 10, 0, 0, 0, tokyo),
+		ExDates: TimeList{time.Date(2025, 5, 15, 9, 0, 0, 0, tokyo)}, RecurrenceID: &rid,
+	}
+	if err := e.BeforeSave(nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, got := range []time.Time{e.StartDate, e.EndDate, e.ExDates[0], *e.RecurrenceID} {
+		if got.Location() != time.UTC {
+			t.Errorf("Expected %v in UTC", got)
+		}
+	}
+	if !e.StartDate.Equal(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the same instant, got %v", e.StartDate)
+	}
+}
+
+func TestEvent_Validate(t *testing.T) {
+	start := date(2025, 5, 1, 18)
+	tests := []struct {
+		name  string
+		event Event
+		want  error
+	}{
+		{"valid", Event{StartDate: start, EndDate: start.Add(time.Hour), RRule: "FREQ=WEEKLY"}, nil},
+		{"no start", Event{}, ErrInvalidArgument},
+		{"end first", Event{StartDate: start, EndDate: start.Add(-time.Hour)}, ErrInvalidArgument},
+		{"bad rrule", Event{StartDate: start, EndDate: start, RRule: "FREQ=HOURLY"}, ErrInvalidRRule},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.event.Validate(); !errors.Is(err, tt.want) {
+				t.Errorf("Validate() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvent_BeforeSaveAllDay(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	stored := Event{AllDay: true, StartDate: date(2025, 5, 1, 0), EndDate: date(2025, 5, 2, 0)}
+	e := stored.In(tokyo)
+	if err := e.BeforeSave(nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e.StartDate != stored.StartDate || e.EndDate != stored.EndDate {
+		t.Errorf("Expected the dates to be stored as %v - %v, got %v - %v", stored.StartDate, stored.EndDate, e.StartDate, e.EndDate)
+	}
+}
@@ -2,9 +2,12 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hmlylab/common/logger"
 	"gorm.io/gorm"
 
 	"github.com/clerk/clerk-sdk-go/v2"
@@ -40,6 +43,32 @@ func (m *BaseModel) BeforeCreate(tx *gorm.DB) (err error) {
 type Household struct {
 	BaseModel
 	Name string `json:"name"`
+	// TimeZone is the IANA name of the zone the household lives in, e.g.
+	// "Europe/London". Empty means UTC.
+	TimeZone string `json:"timeZone"`
+}
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+// ErrInvalidArgument is wrapped by errors, here and in the packages built on
+// domain, that mean a caller sent something invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// Location returns the household's time zone.
+func (h Household) Location() (*time.Location, error) {
+	if h.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(h.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrInvalidTimeZone, h.TimeZone)
+	}
+	return loc, nil
+}
+
+func (h *Household) BeforeSave(tx *gorm.DB) error {
+	_, err := h.Location()
+	return err
 }
 
 type Member struct {
@@ -56,12 +85,30 @@ type Meal struct {
 
 type Event struct {
 	BaseModel
-	Name       string    `json:"name"`
-	EntityID   string    `json:"entityId"`   // Could be HouseholdID or MealID etc.
-	EntityType string    `json:"entityType"` // e.g., "household", "meal", "chore"
-	StartDate  time.Time `json:"startDate" gorm:"autoUpdateTime:false"`
-	EndDate    time.Time `json:"endDate" gorm:"autoUpdateTime:false"`
-	AssignedTo string    `json:"assignedTo"` // UserID of the person assigned to this event
+	Name        string    `json:"name"`
+	EntityID    string    `json:"entityId" gorm:"index:idx_events_entity,priority:2"`   // Could be HouseholdID or MealID etc.
+	EntityType  string    `json:"entityType" gorm:"index:idx_events_entity,priority:1"` // e.g., "household", "meal", "chore"
+	HouseholdID string    `json:"householdId" gorm:"index:idx_events_household_start,priority:1;index:idx_events_household_uid,priority:1"`
+	StartDate   time.Time `json:"startDate" gorm:"autoUpdateTime:false;index:idx_events_window,priority:1;index:idx_events_household_start,priority:2;index:idx_events_assignee_start,priority:2"`
+	EndDate     time.Time `json:"endDate" gorm:"autoUpdateTime:false;index:idx_events_window,priority:2"`
+	AssignedTo  string    `json:"assignedTo" gorm:"index:idx_events_assignee_start,priority:1"` // UserID of the person assigned to this event
+	// AllDay events keep their dates as midnight UTC, from the first day to
+	// the day after the last, and fall on those dates in any time zone. See
+	// Event.In.
+	AllDay bool `json:"allDay" gorm:"not null;default:false"`
+
+	// RRule makes the event repeat, e.g. "FREQ=WEEKLY;BYDAY=MO,TH". StartDate
+	// and EndDate are then the first occurrence. ExDates are the starts of
+	// cancelled occurrences.
+	RRule   string   `json:"rrule,omitempty" gorm:"column:rrule"`
+	ExDates TimeList `json:"exDates,omitempty" gorm:"column:exdates"`
+	// SeriesID and RecurrenceID are set on an event that replaces the
+	// occurrence of the series SeriesID originally starting at RecurrenceID.
+	SeriesID     string     `json:"seriesId,omitempty" gorm:"index:idx_events_series"`
+	RecurrenceID *time.Time `json:"recurrenceId,omitempty"`
+	// ExternalUID is the UID of the iCalendar event this one was imported
+	// from, so importing the same calendar again updates it.
+	ExternalUID string `json:"externalUid,omitempty" gorm:"index:idx_events_household_uid,priority:2"`
 }
 
 type User struct {
@@ -71,6 +118,15 @@ type User struct {
 	Password string `json:"password"`
 }
 
+// LogValue masks the user's personal data so a logged User never leaks it.
+func (u User) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", u.ID),
+		slog.String("name", redactNonEmpty(u.Name)),
+		slog.String("email", logger.MaskEmail(u.Email)),
+	)
+}
+
 type UserData struct {
 	ID                  string              `json:"id"`
 	Username            string              `json:"username"`
@@ -80,3 +136,21 @@ type UserData struct {
 	PrimaryEmailAddress string              `json:"primaryEmailAddress"`
 	EmailAddresses      *clerk.EmailAddress `json:"emailAddresses"`
 }
+
+// LogValue keeps only the Clerk ID and a masked primary email.
+func (u UserData) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", u.ID),
+		slog.String("username", redactNonEmpty(u.Username)),
+		slog.String("firstName", redactNonEmpty(u.FirstName)),
+		slog.String("lastName", redactNonEmpty(u.LastName)),
+		slog.String("primaryEmailAddress", logger.MaskEmail(u.PrimaryEmailAddress)),
+	)
+}
+
+func redactNonEmpty(s string) string {
+	if s == "" {
+		return ""
+	}
+	return logger.Redacted
+}
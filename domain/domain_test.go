@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"errors"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -86,6 +89,45 @@ func TestHousehold(t *testing.T) {
 	}
 }
 
+func TestHousehold_Location(t *testing.T) {
+	loc, err := Household{}.Location()
+	if err != nil || loc != time.UTC {
+		t.Errorf("Location() = %v, %v; want UTC", loc, err)
+	}
+	loc, err = Household{TimeZone: "America/New_York"}.Location()
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	if loc.String() != "America/New_York" {
+		t.Errorf("Location() = %v, want America/New_York", loc)
+	}
+	if _, err := (Household{TimeZone: "Mars/Olympus"}).Location(); !errors.Is(err, ErrInvalidTimeZone) {
+		t.Errorf("Location() error = %v, want ErrInvalidTimeZone", err)
+	}
+}
+
+func TestHousehold_BeforeSave(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+	if err := db.AutoMigrate(&Household{}); err != nil {
+		t.Fatalf("Failed to migrate test database: %v", err)
+	}
+
+	if err := db.Create(&Household{Name: "Nowhere", TimeZone: "Mars/Olympus"}).Error; !errors.Is(err, ErrInvalidTimeZone) {
+		t.Errorf("Create() error = %v, want ErrInvalidTimeZone", err)
+	}
+	household := &Household{Name: "Home", TimeZone: "UTC"}
+	if err := db.Create(household).Error; err != nil {
+		t.Errorf("Create() error = %v", err)
+	}
+	household.TimeZone = "Nowhere/Special"
+	if err := db.Save(household).Error; !errors.Is(err, ErrInvalidTimeZone) {
+		t.Errorf("Save() error = %v, want ErrInvalidTimeZone", err)
+	}
+}
+
 func TestMember(t *testing.T) {
 	now := time.Now()
 	member := Member{
@@ -424,3 +466,31 @@ func BenchmarkBaseModel_BeforeCreate(b *testing.B) {
 		model.BeforeCreate(db)
 	}
 }
+
+func TestUser_LogValue(t *testing.T) {
+	user := User{BaseModel: BaseModel{ID: "u1"}, Name: "Jane Doe", Email: "jane@example.com", Password: "hunter2"}
+	out := slog.AnyValue(user).Resolve().String()
+
+	for _, leaked := range []string{"Jane", "jane@example.com", "hunter2"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("Expected %q to be masked in %s", leaked, out)
+		}
+	}
+	if !strings.Contains(out, "u1") || !strings.Contains(out, "j***@example.com") {
+		t.Errorf("Unexpected log value %s", out)
+	}
+}
+
+func TestUserData_LogValue(t *testing.T) {
+	data := &UserData{ID: "user_1", Username: "jdoe", FirstName: "Jane", LastName: "Doe", PrimaryEmailAddress: "jane@example.com"}
+	out := slog.AnyValue(data).Resolve().String()
+
+	for _, leaked := range []string{"jdoe", "Jane", "Doe", "jane@example.com"} {
+		if strings.Contains(out, leaked) {
+			t.Errorf("Expected %q to be masked in %s", leaked, out)
+		}
+	}
+	if !strings.Contains(out, "user_1") {
+		t.Errorf("Expected the ID to be kept, got %s", out)
+	}
+}
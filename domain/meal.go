@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidMealSlot = errors.New("invalid meal slot")
+	ErrInvalidRecipe   = errors.New("invalid recipe")
+	ErrRecipeInUse     = errors.New("recipe is used by the meal plan")
+)
+
+// Recipe is a dish a household cooks. Its ingredient quantities make
+// Servings portions.
+type Recipe struct {
+	BaseModel
+	HouseholdID string `json:"householdId" gorm:"index"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Servings    int    `json:"servings"`
+	// Steps are the method, in order.
+	Steps StringList `json:"steps,omitempty"`
+	Tags  StringList `json:"tags,omitempty"`
+	// Ingredients are in the order they are listed; repositories load and
+	// replace them with the recipe.
+	Ingredients []Ingredient `json:"ingredients,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// Ingredient is an amount of something a recipe needs, e.g. 200 "g" of
+// "flour". Quantity is zero for ingredients used to taste.
+type Ingredient struct {
+	BaseModel
+	RecipeID string  `json:"recipeId" gorm:"index"`
+	Position int     `json:"position"`
+	Name     string  `json:"name"`
+	Quantity float64 `json:"quantity"`
+	Unit     string  `json:"unit,omitempty"`
+	Note     string  `json:"note,omitempty"`
+}
+
+func (r *Recipe) BeforeSave(tx *gorm.DB) error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidRecipe)
+	}
+	if r.Servings < 0 {
+		return fmt.Errorf("%w: servings cannot be negative", ErrInvalidRecipe)
+	}
+	for i := range r.Ingredients {
+		in := &r.Ingredients[i]
+		if strings.TrimSpace(in.Name) == "" {
+			return fmt.Errorf("%w: ingredient %d has no name", ErrInvalidRecipe, i+1)
+		}
+		if in.Quantity < 0 {
+			return fmt.Errorf("%w: ingredient %q has a negative quantity", ErrInvalidRecipe, in.Name)
+		}
+		in.Position = i
+	}
+	return nil
+}
+
+// MealSlot is the meal of the day a plan entry is for.
+type MealSlot string
+
+const (
+	Breakfast MealSlot = "breakfast"
+	Lunch     MealSlot = "lunch"
+	Dinner    MealSlot = "dinner"
+	Snack     MealSlot = "snack"
+)
+
+// MealSlots lists the slots in the order they come in a day.
+var MealSlots = []MealSlot{Breakfast, Lunch, Dinner, Snack}
+
+func (s MealSlot) Valid() bool {
+	for _, slot := range MealSlots {
+		if s == slot {
+			return true
+		}
+	}
+	return false
+}
+
+// MealPlanEntry plans a recipe for a meal of a household's day.
+type MealPlanEntry struct {
+	BaseModel
+	HouseholdID string `json:"householdId" gorm:"index:idx_meal_plan_household_date,priority:1"`
+	// Date is the day of the meal, stored as midnight UTC like all-day
+	// events.
+	Date     time.Time `json:"date" gorm:"autoUpdateTime:false;index:idx_meal_plan_household_date,priority:2"`
+	Slot     MealSlot  `json:"slot"`
+	RecipeID string    `json:"recipeId" gorm:"index"`
+	Recipe   *Recipe   `json:"recipe,omitempty" gorm:"constraint:OnDelete:RESTRICT"`
+	// Servings is how many portions to make; zero means the recipe's own.
+	Servings int    `json:"servings"`
+	Note     string `json:"note,omitempty"`
+}
+
+func (e *MealPlanEntry) BeforeSave(tx *gorm.DB) error {
+	if !e.Slot.Valid() {
+		return fmt.Errorf("%w %q", ErrInvalidMealSlot, e.Slot)
+	}
+	if e.Servings < 0 {
+		return fmt.Errorf("%w: servings cannot be negative", ErrInvalidRecipe)
+	}
+	e.Date = AllDayDate(e.Date)
+	return nil
+}
+
+// StringList is a list of strings stored as JSON text.
+type StringList []string
+
+func (StringList) GormDataType() string {
+	return "text"
+}
+
+func (l StringList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal([]string(l))
+	return string(b), err
+}
+
+func (l *StringList) Scan(src any) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return fmt.Errorf("cannot scan %T into StringList", src)
+	}
+	*l = nil
+	if len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, (*[]string)(l))
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStringList_ValueScan(t *testing.T) {
+	in := StringList{"Chop, then fry", `Serve "hot"`}
+	v, err := in.Value()
+	if err != nil {
+		t.Fatalf("Value: %v", err)
+	}
+	var out StringList
+	if err := out.Scan(v); err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("Expected %q, got %q", in, out)
+	}
+
+	if v, _ := StringList(nil).Value(); v != nil {
+		t.Errorf("Expected NULL for an empty list, got %v", v)
+	}
+	if err := out.Scan(nil); err != nil || out != nil {
+		t.Errorf("Scan(nil) = %v, %v", out, err)
+	}
+	if err := out.Scan(42); err == nil {
+		t.Error("Expected an error scanning an int")
+	}
+}
+
+func TestRecipe_BeforeSave(t *testing.T) {
+	r := Recipe{Name: "Soup", Ingredients: []Ingredient{{Name: "water", Position: 5}, {Name: "salt"}}}
+	if err := r.BeforeSave(nil); err != nil {
+		t.Fatalf("BeforeSave: %v", err)
+	}
+	if r.Ingredients[0].Position != 0 || r.Ingredients[1].Position != 1 {
+		t.Errorf("Expected positions in list order, got %+v", r.Ingredients)
+	}
+
+	for name, r := range map[string]Recipe{
+		"no name":           {},
+		"negative servings": {Name: "Soup", Servings: -1},
+		"unnamed":           {Name: "Soup", Ingredients: []Ingredient{{Quantity: 1}}},
+		"negative quantity": {Name: "Soup", Ingredients: []Ingredient{{Name: "water", Quantity: -1}}},
+	} {
+		if err := r.BeforeSave(nil); !errors.Is(err, ErrInvalidRecipe) {
+			t.Errorf("%s: expected ErrInvalidRecipe, got %v", name, err)
+		}
+	}
+}
+
+func TestMealPlanEntry_BeforeSave(t *testing.T) {
+	e := MealPlanEntry{Slot: Dinner, Date: time.Date(2025, 5, 6, 20, 0, 0, 0, time.FixedZone("EST", -5*60*60))}
+	if err := e.BeforeSave(nil); err != nil {
+		t.Fatalf("BeforeSave: %v", err)
+	}
+	if want := time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC); !e.Date.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, e.Date)
+	}
+
+	e.Slot = "elevenses"
+	if err := e.BeforeSave(nil); !errors.Is(err, ErrInvalidMealSlot) {
+		t.Errorf("Expected ErrInvalidMealSlot, got %v", err)
+	}
+}
@@ -0,0 +1,589 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency is the FREQ of a recurrence rule.
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+var (
+	ErrInvalidRRule    = errors.New("invalid recurrence rule")
+	ErrNotRecurring    = errors.New("event does not recur")
+	ErrNotAnOccurrence = errors.New("not an occurrence of the event")
+)
+
+// maxPeriods bounds how many days, weeks or months a rule is scanned for, so
+// a rule that never matches (BYMONTHDAY=31 every 12 months from April) ends.
+const maxPeriods = 50000
+
+const (
+	untilLayout     = "20060102T150405Z"
+	untilDateLayout = "20060102"
+)
+
+// WeekdayNum is a BYDAY entry: a weekday, optionally with an ordinal within
+// the month such as 2 for the second Tuesday (2TU) or -1 for the last
+// Friday (-1FR). N is 0 for every such weekday.
+type WeekdayNum struct {
+	N       int
+	Weekday time.Weekday
+}
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+func (w WeekdayNum) String() string {
+	if w.N == 0 {
+		return weekdayCodes[w.Weekday]
+	}
+	return strconv.Itoa(w.N) + weekdayCodes[w.Weekday]
+}
+
+// RecurrenceRule is the subset of an RFC 5545 RRULE that events support:
+// FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL, COUNT, UNTIL, BYDAY and
+// BYMONTHDAY. Weeks start on Monday.
+type RecurrenceRule struct {
+	Freq     Frequency
+	Interval int
+	// Count limits the number of occurrences, including the first.
+	Count int
+	// Until is the last time an occurrence may start, inclusive.
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+}
+
+// ParseRRule parses a rule such as "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10", with
+// or without the "RRULE:" prefix.
+func ParseRRule(s string) (RecurrenceRule, error) {
+	r := RecurrenceRule{Interval: 1}
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return RecurrenceRule{}, fmt.Errorf("%w: %q is not NAME=VALUE", ErrInvalidRRule, part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+			if r.Freq != Daily && r.Freq != Weekly && r.Freq != Monthly {
+				err = fmt.Errorf("FREQ %s is not DAILY, WEEKLY or MONTHLY", value)
+			}
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+			if err == nil && r.Interval < 1 {
+				err = fmt.Errorf("INTERVAL %d must be positive", r.Interval)
+			}
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+			if err == nil && r.Count < 1 {
+				err = fmt.Errorf("COUNT %d must be positive", r.Count)
+			}
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseByMonthDay(value)
+		case "WKST":
+			if strings.ToUpper(value) != "MO" {
+				err = fmt.Errorf("WKST %s is not supported", value)
+			}
+		default:
+			err = fmt.Errorf("%s is not supported", name)
+		}
+		if err != nil {
+			return RecurrenceRule{}, fmt.Errorf("%w: %v", ErrInvalidRRule, err)
+		}
+	}
+	if err := r.validate(); err != nil {
+		return RecurrenceRule{}, fmt.Errorf("%w: %v", ErrInvalidRRule, err)
+	}
+	return r, nil
+}
+
+func (r RecurrenceRule) validate() error {
+	if r.Freq == "" {
+		return errors.New("FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errors.New("COUNT and UNTIL cannot both be set")
+	}
+	if r.Freq == Weekly && len(r.ByMonthDay) > 0 {
+		return errors.New("BYMONTHDAY cannot be used with WEEKLY")
+	}
+	if r.Freq != Monthly {
+		for _, d := range r.ByDay {
+			if d.N != 0 {
+				return fmt.Errorf("BYDAY %s needs FREQ=MONTHLY", d)
+			}
+		}
+	}
+	return nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse(untilLayout, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("20060102T150405", value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(untilDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("UNTIL %s is not a date or UTC date-time", value)
+	}
+	// A date includes the whole day.
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(strings.ToUpper(value), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("BYDAY %s is not a weekday", item)
+		}
+		code, prefix := item[len(item)-2:], item[:len(item)-2]
+		wd := slices.Index(weekdayCodes, code)
+		if wd < 0 {
+			return nil, fmt.Errorf("BYDAY %s is not a weekday", item)
+		}
+		n := 0
+		if prefix != "" {
+			var err error
+			if n, err = strconv.Atoi(prefix); err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("BYDAY %s has an invalid ordinal", item)
+			}
+		}
+		days = append(days, WeekdayNum{N: n, Weekday: time.Weekday(wd)})
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		d, err := strconv.Atoi(item)
+		if err != nil || d == 0 || d < -31 || d > 31 {
+			return nil, fmt.Errorf("BYMONTHDAY %s is not a day of the month", item)
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
+
+// String formats r as an RRULE value without the "RRULE:" prefix.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, d := range r.ByDay {
+			days[i] = d.String()
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := make([]string, len(r.ByMonthDay))
+		for i, d := range r.ByMonthDay {
+			days[i] = strconv.Itoa(d)
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// starts calls yield with each occurrence start of a series beginning at
+// dtstart, in order, until yield returns false, the rule ends or an
+// occurrence would start at or after end. The first occurrence is always
+// dtstart, as in RFC 5545. Occurrences keep dtstart's wall clock time in its
+// location across daylight saving changes.
+func (r RecurrenceRule) starts(dtstart, end time.Time, yield func(time.Time) bool) {
+	n := 0
+	emit := func(t time.Time) bool {
+		if !t.Before(end) || (!r.Until.IsZero() && t.After(r.Until)) {
+			return false
+		}
+		n++
+		return yield(t) && (r.Count == 0 || n < r.Count)
+	}
+	if !emit(dtstart) {
+		return
+	}
+	for p := 0; p < maxPeriods; p++ {
+		candidates, periodStart := r.candidates(dtstart, p)
+		if !periodStart.Before(end) {
+			return
+		}
+		for _, t := range candidates {
+			if t.After(dtstart) && !emit(t) {
+				return
+			}
+		}
+	}
+}
+
+// candidates returns the starts matched by r in the p-th day, week or month
+// after dtstart's, and the start of that period.
+func (r RecurrenceRule) candidates(dtstart time.Time, p int) ([]time.Time, time.Time) {
+	y, m, d := dtstart.Date()
+	hh, mm, ss := dtstart.Clock()
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, hh, mm, ss, dtstart.Nanosecond(), dtstart.Location())
+	}
+
+	var out []time.Time
+	switch r.Freq {
+	case Daily:
+		t := at(y, m, d+p*r.Interval)
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		if r.matchesWeekday(t) && r.matchesMonthDay(t.Day(), daysIn(t.Year(), t.Month())) {
+			out = append(out, t)
+		}
+		return out, day
+	case Weekly:
+		monday := d - (int(dtstart.Weekday())+6)%7 + 7*p*r.Interval
+		for i := 0; i < 7; i++ {
+			t := at(y, m, monday+i)
+			if len(r.ByDay) == 0 && t.Weekday() == dtstart.Weekday() || len(r.ByDay) > 0 && r.matchesWeekday(t) {
+				out = append(out, t)
+			}
+		}
+		return out, time.Date(y, m, monday, 0, 0, 0, 0, dtstart.Location())
+	default:
+		first := time.Date(y, m+time.Month(p*r.Interval), 1, 0, 0, 0, 0, dtstart.Location())
+		dim := daysIn(first.Year(), first.Month())
+		if len(r.ByDay) == 0 && len(r.ByMonthDay) == 0 {
+			// Months without dtstart's day, like February for the 30th,
+			// are skipped.
+			if d <= dim {
+				out = append(out, at(first.Year(), first.Month(), d))
+			}
+			return out, first
+		}
+		for day := 1; day <= dim; day++ {
+			t := at(first.Year(), first.Month(), day)
+			if r.matchesMonthDay(day, dim) && r.matchesNthWeekday(t, dim) {
+				out = append(out, t)
+			}
+		}
+		return out, first
+	}
+}
+
+func (r RecurrenceRule) matchesWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if d.Weekday == t.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r RecurrenceRule) matchesNthWeekday(t time.Time, dim int) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, d := range r.ByDay {
+		if d.Weekday != t.Weekday() {
+			continue
+		}
+		switch {
+		case d.N == 0,
+			d.N > 0 && (t.Day()-1)/7+1 == d.N,
+			d.N < 0 && (dim-t.Day())/7+1 == -d.N:
+			return true
+		}
+	}
+	return false
+}
+
+func (r RecurrenceRule) matchesMonthDay(day, dim int) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	for _, md := range r.ByMonthDay {
+		if md == day || md < 0 && dim+md+1 == day {
+			return true
+		}
+	}
+	return false
+}
+
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// TimeList is a list of times stored as comma-separated RFC 3339 text.
+type TimeList []time.Time
+
+func (l TimeList) Contains(t time.Time) bool {
+	for _, x := range l {
+		if x.Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (TimeList) GormDataType() string {
+	return "text"
+}
+
+func (l TimeList) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	parts := make([]string, len(l))
+	for i, t := range l {
+		parts[i] = t.UTC().Format(time.RFC3339Nano)
+	}
+	return strings.Join(parts, ","), nil
+}
+
+func (l *TimeList) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("cannot scan %T into TimeList", src)
+	}
+	*l = nil
+	if s == "" {
+		return nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		t, err := time.Parse(time.RFC3339Nano, part)
+		if err != nil {
+			return err
+		}
+		*l = append(*l, t)
+	}
+	return nil
+}
+
+// Occurrence is one concrete instance of an event. Event is the series with
+// StartDate and EndDate moved to this instance, or the override that
+// replaces it. RecurrenceID is the instance's original start, which
+// identifies it within the series.
+type Occurrence struct {
+	Event        Event
+	RecurrenceID time.Time
+}
+
+func (e Event) IsRecurring() bool {
+	return e.RRule != ""
+}
+
+// IsOverride reports whether e replaces a single occurrence of a series.
+func (e Event) IsOverride() bool {
+	return e.SeriesID != "" && e.RecurrenceID != nil
+}
+
+// Recurrence parses e's RRule.
+func (e Event) Recurrence() (RecurrenceRule, error) {
+	if !e.IsRecurring() {
+		return RecurrenceRule{}, ErrNotRecurring
+	}
+	return ParseRRule(e.RRule)
+}
+
+// Occurrences returns the occurrences of e that overlap [from, to), in
+// order, leaving out ExDates. A one-off event is its own single occurrence.
+func (e Event) Occurrences(from, to time.Time) ([]Occurrence, error) {
+	duration := e.EndDate.Sub(e.StartDate)
+	if !e.IsRecurring() {
+		if overlaps(e.StartDate, e.EndDate, from, to) {
+			return []Occurrence{{Event: e, RecurrenceID: e.StartDate}}, nil
+		}
+		return nil, nil
+	}
+	rule, err := e.Recurrence()
+	if err != nil {
+		return nil, err
+	}
+	var out []Occurrence
+	rule.starts(e.StartDate, to, func(start time.Time) bool {
+		end := start.Add(duration)
+		if overlaps(start, end, from, to) && !e.ExDates.Contains(start) {
+			occ := e
+			occ.StartDate, occ.EndDate = start, end
+			out = append(out, Occurrence{Event: occ, RecurrenceID: start})
+		}
+		return true
+	})
+	return out, nil
+}
+
+// overlaps reports whether [start, end) overlaps [from, to). Zero-length
+// events overlap the window they start in.
+func overlaps(start, end, from, to time.Time) bool {
+	if !end.After(start) {
+		return !start.Before(from) && start.Before(to)
+	}
+	return start.Before(to) && end.After(from)
+}
+
+// ExpandEvents returns the occurrences of events that overlap [from, to),
+// ordered by start. Overrides among events replace the occurrence of their
+// series they were made for, wherever either of them falls.
+func ExpandEvents(events []Event, from, to time.Time) ([]Occurrence, error) {
+	overridden := make(map[string]bool)
+	var out []Occurrence
+	for _, e := range events {
+		if !e.IsOverride() {
+			continue
+		}
+		overridden[overrideKey(e.SeriesID, *e.RecurrenceID)] = true
+		if overlaps(e.StartDate, e.EndDate, from, to) {
+			out = append(out, Occurrence{Event: e, RecurrenceID: *e.RecurrenceID})
+		}
+	}
+	for _, e := range events {
+		if e.IsOverride() {
+			continue
+		}
+		occurrences, err := e.Occurrences(from, to)
+		if err != nil {
+			return nil, fmt.Errorf("event %s: %w", e.ID, err)
+		}
+		for _, occ := range occurrences {
+			if !overridden[overrideKey(e.ID, occ.RecurrenceID)] {
+				out = append(out, occ)
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Event.StartDate.Before(out[j].Event.StartDate)
+	})
+	return out, nil
+}
+
+func overrideKey(seriesID string, recurrenceID time.Time) string {
+	return seriesID + "@" + recurrenceID.UTC().Format(time.RFC3339Nano)
+}
+
+// isOccurrence reports whether e has an occurrence starting at t.
+func (e Event) isOccurrence(t time.Time) (bool, error) {
+	rule, err := e.Recurrence()
+	if err != nil {
+		return false, err
+	}
+	found := false
+	rule.starts(e.StartDate, t.Add(time.Nanosecond), func(start time.Time) bool {
+		found = start.Equal(t)
+		return !found
+	})
+	return found && !e.ExDates.Contains(t), nil
+}
+
+// OverrideOccurrence returns a one-off event that replaces the occurrence
+// of e starting at recurrenceID, for "this occurrence" edits. Apply the edit
+// to it and create it; ExpandEvents shows it instead of the occurrence.
+func (e Event) OverrideOccurrence(recurrenceID time.Time) (Event, error) {
+	ok, err := e.isOccurrence(recurrenceID)
+	if err != nil {
+		return Event{}, err
+	}
+	if !ok {
+		return Event{}, ErrNotAnOccurrence
+	}
+	override := e
+	override.BaseModel = BaseModel{}
+	override.RRule, override.ExDates = "", nil
+	override.SeriesID = e.ID
+	override.RecurrenceID = &recurrenceID
+	override.StartDate = recurrenceID
+	override.EndDate = recurrenceID.Add(e.EndDate.Sub(e.StartDate))
+	return override, nil
+}
+
+// ExcludeOccurrence cancels the occurrence of e starting at recurrenceID.
+func (e *Event) ExcludeOccurrence(recurrenceID time.Time) error {
+	ok, err := e.isOccurrence(recurrenceID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotAnOccurrence
+	}
+	e.ExDates = append(e.ExDates, recurrenceID)
+	return nil
+}
+
+// SplitAt ends e's series just before the occurrence at recurrenceID and
+// returns a new series that continues from it, for "this and all future
+// occurrences" edits. Apply the edit to the returned event, then update e
+// and create the new series. To change every occurrence, edit e instead.
+func (e *Event) SplitAt(recurrenceID time.Time) (Event, error) {
+	ok, err := e.isOccurrence(recurrenceID)
+	if err != nil {
+		return Event{}, err
+	}
+	if !ok {
+		return Event{}, ErrNotAnOccurrence
+	}
+	if recurrenceID.Equal(e.StartDate) {
+		return Event{}, fmt.Errorf("%w: the first occurrence starts the series", ErrNotAnOccurrence)
+	}
+	rule, _ := e.Recurrence()
+
+	before := 0
+	rule.starts(e.StartDate, recurrenceID, func(time.Time) bool {
+		before++
+		return true
+	})
+
+	next := *e
+	next.BaseModel = BaseModel{}
+	next.StartDate = recurrenceID
+	next.EndDate = recurrenceID.Add(e.EndDate.Sub(e.StartDate))
+	next.ExDates = nil
+	var kept TimeList
+	for _, t := range e.ExDates {
+		if t.Before(recurrenceID) {
+			kept = append(kept, t)
+		} else {
+			next.ExDates = append(next.ExDates, t)
+		}
+	}
+
+	head, tail := rule, rule
+	if rule.Count > 0 {
+		head.Count, tail.Count = before, rule.Count-before
+	} else {
+		head.Until = recurrenceID.Add(-time.Second).UTC()
+	}
+	e.RRule, e.ExDates = head.String(), kept
+	next.RRule = tail.String()
+	return next, nil
+}
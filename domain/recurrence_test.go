@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func date(y int, m time.Month, d, h int) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+}
+
+func starts(t *testing.T, e Event, from, to time.Time) []time.Time {
+	t.Helper()
+	occurrences, err := e.Occurrences(from, to)
+	if err != nil {
+		t.Fatalf("Occurrences() error = %v", err)
+	}
+	out := make([]time.Time, len(occurrences))
+	for i, occ := range occurrences {
+		out[i] = occ.Event.StartDate
+	}
+	return out
+}
+
+func assertTimes(t *testing.T, got []time.Time, want ...time.Time) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseRRule(t *testing.T) {
+	valid := map[string]string{
+		"FREQ=WEEKLY;BYDAY=MO,TH;COUNT=10":           "FREQ=WEEKLY;COUNT=10;BYDAY=MO,TH",
+		"RRULE:FREQ=daily;INTERVAL=2":                "FREQ=DAILY;INTERVAL=2",
+		"FREQ=MONTHLY;BYDAY=-1FR;UNTIL=20251231":     "FREQ=MONTHLY;UNTIL=20251231T235959Z;BYDAY=-1FR",
+		"FREQ=MONTHLY;BYMONTHDAY=1,-1;WKST=MO":       "FREQ=MONTHLY;BYMONTHDAY=1,-1",
+		"FREQ=DAILY;UNTIL=20250301T090000Z;BYDAY=SA": "FREQ=DAILY;UNTIL=20250301T090000Z;BYDAY=SA",
+	}
+	for in, want := range valid {
+		r, err := ParseRRule(in)
+		if err != nil {
+			t.Errorf("ParseRRule(%q) error = %v", in, err)
+			continue
+		}
+		if r.String() != want {
+			t.Errorf("ParseRRule(%q).String() = %q, want %q", in, r.String(), want)
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	} {
+		if _, err := ParseRRule(in); !errors.Is(err, ErrInvalidRRule) {
+			t.Errorf("ParseRRule(%q) error = %v, want ErrInvalidRRule", in, err)
+		}
+	}
+}
+
+func TestEvent_Occurrences(t *testing.T) {
+	window := func(e Event) []time.Time {
+		return starts(t, e, date(2025, 1, 1, 0), date(2026, 1, 1, 0))
+	}
+
+	t.Run("weekly by day with count", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 1, 6, 9), EndDate: date(2025, 1, 6, 10), RRule: "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=5"}
+		assertTimes(t, window(e), date(2025, 1, 6, 9), date(2025, 1, 9, 9), date(2025, 1, 13, 9), date(2025, 1, 16, 9), date(2025, 1, 20, 9))
+	})
+
+	t.Run("every other day until", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 3, 1, 18), EndDate: date(2025, 3, 1, 19), RRule: "FREQ=DAILY;INTERVAL=2;UNTIL=20250307T180000Z"}
+		assertTimes(t, window(e), date(2025, 3, 1, 18), date(2025, 3, 3, 18), date(2025, 3, 5, 18), date(2025, 3, 7, 18))
+	})
+
+	t.Run("biweekly on the start weekday", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 1, 1, 8), EndDate: date(2025, 1, 1, 8), RRule: "FREQ=WEEKLY;INTERVAL=2;COUNT=3"}
+		assertTimes(t, window(e), date(2025, 1, 1, 8), date(2025, 1, 15, 8), date(2025, 1, 29, 8))
+	})
+
+	t.Run("monthly by nth weekday", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 1, 14, 19), EndDate: date(2025, 1, 14, 20), RRule: "FREQ=MONTHLY;BYDAY=2TU;COUNT=3"}
+		assertTimes(t, window(e), date(2025, 1, 14, 19), date(2025, 2, 11, 19), date(2025, 3, 11, 19))
+
+		last := Event{StartDate: date(2025, 1, 31, 12), EndDate: date(2025, 1, 31, 13), RRule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3"}
+		assertTimes(t, window(last), date(2025, 1, 31, 12), date(2025, 2, 28, 12), date(2025, 3, 28, 12))
+	})
+
+	t.Run("monthly skips short months", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 1, 31, 9), EndDate: date(2025, 1, 31, 9), RRule: "FREQ=MONTHLY;COUNT=3"}
+		assertTimes(t, window(e), date(2025, 1, 31, 9), date(2025, 3, 31, 9), date(2025, 5, 31, 9))
+
+		lastDay := Event{StartDate: date(2025, 1, 31, 9), EndDate: date(2025, 1, 31, 9), RRule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3"}
+		assertTimes(t, window(lastDay), date(2025, 1, 31, 9), date(2025, 2, 28, 9), date(2025, 3, 31, 9))
+	})
+
+	t.Run("exdates are skipped but still counted", func(t *testing.T) {
+		e := Event{
+			StartDate: date(2025, 2, 1, 9), EndDate: date(2025, 2, 1, 10),
+			RRule: "FREQ=DAILY;COUNT=4", ExDates: TimeList{date(2025, 2, 2, 9)},
+		}
+		assertTimes(t, window(e), date(2025, 2, 1, 9), date(2025, 2, 3, 9), date(2025, 2, 4, 9))
+	})
+
+	t.Run("window", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 1, 1, 22), EndDate: date(2025, 1, 2, 2), RRule: "FREQ=DAILY"}
+		// The occurrence started on the 3rd runs into the window.
+		assertTimes(t, starts(t, e, date(2025, 1, 4, 0), date(2025, 1, 5, 0)), date(2025, 1, 3, 22), date(2025, 1, 4, 22))
+	})
+
+	t.Run("keeps wall clock time across DST", func(t *testing.T) {
+		ny, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skip("time zone data unavailable")
+		}
+		start := time.Date(2025, 3, 3, 9, 0, 0, 0, ny)
+		e := Event{StartDate: start, EndDate: start.Add(time.Hour), RRule: "FREQ=WEEKLY;COUNT=2"}
+		assertTimes(t, window(e), start, time.Date(2025, 3, 10, 9, 0, 0, 0, ny))
+	})
+
+	t.Run("one-off", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 6, 1, 9), EndDate: date(2025, 6, 1, 10)}
+		assertTimes(t, window(e), date(2025, 6, 1, 9))
+		assertTimes(t, starts(t, e, date(2025, 7, 1, 0), date(2025, 8, 1, 0)))
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		e := Event{StartDate: date(2025, 6, 1, 9), RRule: "FREQ=HOURLY"}
+		if _, err := e.Occurrences(date(2025, 1, 1, 0), date(2026, 1, 1, 0)); !errors.Is(err, ErrInvalidRRule) {
+			t.Errorf("error = %v, want ErrInvalidRRule", err)
+		}
+	})
+}
+
+func TestEvent_OverrideOccurrence(t *testing.T) {
+	series := Event{
+		BaseModel: BaseModel{ID: "chores"},
+		Name:      "Bins",
+		StartDate: date(2025, 1, 6, 19), EndDate: date(2025, 1, 6, 20),
+		RRule: "FREQ=WEEKLY;COUNT=4",
+	}
+
+	if _, err := series.OverrideOccurrence(date(2025, 1, 7, 19)); !errors.Is(err, ErrNotAnOccurrence) {
+		t.Errorf("error = %v, want ErrNotAnOccurrence", err)
+	}
+
+	override, err := series.OverrideOccurrence(date(2025, 1, 13, 19))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if override.ID != "" || override.RRule != "" || override.SeriesID != "chores" || !override.IsOverride() {
+		t.Errorf("Unexpected override: %+v", override)
+	}
+	override.BaseModel.ID = "moved"
+	override.Name = "Bins (moved)"
+	override.StartDate, override.EndDate = date(2025, 1, 14, 19), date(2025, 1, 14, 20)
+
+	occurrences, err := ExpandEvents([]Event{override, series}, date(2025, 1, 1, 0), date(2025, 2, 1, 0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var got []time.Time
+	for _, occ := range occurrences {
+		got = append(got, occ.Event.StartDate)
+	}
+	assertTimes(t, got, date(2025, 1, 6, 19), date(2025, 1, 14, 19), date(2025, 1, 20, 19), date(2025, 1, 27, 19))
+	if occurrences[1].Event.Name != "Bins (moved)" || !occurrences[1].RecurrenceID.Equal(date(2025, 1, 13, 19)) {
+		t.Errorf("Expected the override in place of the 13th, got %+v", occurrences[1])
+	}
+
+	if err := series.ExcludeOccurrence(date(2025, 1, 20, 19)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	assertTimes(t, starts(t, series, date(2025, 1, 1, 0), date(2025, 2, 1, 0)), date(2025, 1, 6, 19), date(2025, 1, 13, 19), date(2025, 1, 27, 19))
+	if err := series.ExcludeOccurrence(date(2025, 1, 20, 19)); !errors.Is(err, ErrNotAnOccurrence) {
+		t.Errorf("Expected an excluded occurrence to be rejected, got %v", err)
+	}
+}
+
+func TestEvent_SplitAt(t *testing.T) {
+	t.Run("count", func(t *testing.T) {
+		series := Event{
+			BaseModel: BaseModel{ID: "s"},
+			StartDate: date(2025, 1, 6, 9), EndDate: date(2025, 1, 6, 10),
+			RRule:   "FREQ=WEEKLY;BYDAY=MO,TH;COUNT=6",
+			ExDates: TimeList{date(2025, 1, 9, 9), date(2025, 1, 20, 9)},
+		}
+		next, err := series.SplitAt(date(2025, 1, 16, 9))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if series.RRule != "FREQ=WEEKLY;COUNT=3;BYDAY=MO,TH" || next.RRule != "FREQ=WEEKLY;COUNT=3;BYDAY=MO,TH" {
+			t.Errorf("RRules = %q and %q", series.RRule, next.RRule)
+		}
+		if next.ID != "" || !next.EndDate.Equal(date(2025, 1, 16, 10)) {
+			t.Errorf("Unexpected new series: %+v", next)
+		}
+		from, to := date(2025, 1, 1, 0), date(2025, 3, 1, 0)
+		assertTimes(t, starts(t, series, from, to), date(2025, 1, 6, 9), date(2025, 1, 13, 9))
+		assertTimes(t, starts(t, next, from, to), date(2025, 1, 16, 9), date(2025, 1, 23, 9))
+	})
+
+	t.Run("open ended", func(t *testing.T) {
+		series := Event{StartDate: date(2025, 1, 1, 7), EndDate: date(2025, 1, 1, 8), RRule: "FREQ=DAILY"}
+		next, err := series.SplitAt(date(2025, 1, 4, 7))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if series.RRule != "FREQ=DAILY;UNTIL=20250104T065959Z" || next.RRule != "FREQ=DAILY" {
+			t.Errorf("RRules = %q and %q", series.RRule, next.RRule)
+		}
+		next.StartDate, next.EndDate = date(2025, 1, 4, 8), date(2025, 1, 4, 9)
+		events, err := ExpandEvents([]Event{series, next}, date(2025, 1, 2, 0), date(2025, 1, 6, 0))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		var got []time.Time
+		for _, occ := range events {
+			got = append(got, occ.Event.StartDate)
+		}
+		assertTimes(t, got, date(2025, 1, 2, 7), date(2025, 1, 3, 7), date(2025, 1, 4, 8), date(2025, 1, 5, 8))
+	})
+
+	t.Run("first occurrence", func(t *testing.T) {
+		series := Event{StartDate: date(2025, 1, 1, 7), EndDate: date(2025, 1, 1, 8), RRule: "FREQ=DAILY"}
+		if _, err := series.SplitAt(date(2025, 1, 1, 7)); err == nil {
+			t.Error("Expected splitting at the first occurrence to fail")
+		}
+		if _, err := (&Event{StartDate: date(2025, 1, 1, 7)}).SplitAt(date(2025, 1, 1, 7)); !errors.Is(err, ErrNotRecurring) {
+			t.Errorf("error = %v, want ErrNotRecurring", err)
+		}
+	})
+}
+
+func TestEvent_RecurrencePersists(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+	if err := db.AutoMigrate(&Event{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+
+	rid := date(2025, 1, 13, 19)
+	in := Event{
+		Name: "Bins", StartDate: date(2025, 1, 6, 19), EndDate: date(2025, 1, 6, 20),
+		RRule: "FREQ=WEEKLY", ExDates: TimeList{date(2025, 1, 20, 19), date(2025, 1, 27, 19)},
+		SeriesID: "parent", RecurrenceID: &rid,
+	}
+	if err := db.Create(&in).Error; err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	var out Event
+	if err := db.First(&out, "id = ?", in.ID).Error; err != nil {
+		t.Fatalf("First() error = %v", err)
+	}
+	if out.RRule != in.RRule || out.SeriesID != "parent" || out.RecurrenceID == nil || !out.RecurrenceID.Equal(rid) {
+		t.Errorf("Unexpected event: %+v", out)
+	}
+	assertTimes(t, out.ExDates, in.ExDates...)
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrInvalidShoppingList = errors.New("invalid shopping list")
+
+// ShoppingList is what a household needs to buy for the meals planned over
+// a range of days, plus anything members add by hand.
+type ShoppingList struct {
+	BaseModel
+	HouseholdID string `json:"householdId" gorm:"index"`
+	Name        string `json:"name"`
+	// StartDate and EndDate are the days [StartDate, EndDate) whose meal plan
+	// the list is for, stored as midnight UTC like all-day events.
+	StartDate time.Time `json:"startDate" gorm:"autoUpdateTime:false"`
+	EndDate   time.Time `json:"endDate" gorm:"autoUpdateTime:false"`
+	// Items are in the order they are listed; repositories load and replace
+	// them with the list.
+	Items []ShoppingItem `json:"items,omitempty" gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// ShoppingItem is an amount of something to buy. Items from the meal plan
+// name the recipes that need them; Manual items were added by a member.
+type ShoppingItem struct {
+	BaseModel
+	ShoppingListID string     `json:"shoppingListId" gorm:"index"`
+	Position       int        `json:"position"`
+	Name           string     `json:"name"`
+	Quantity       float64    `json:"quantity"`
+	Unit           string     `json:"unit,omitempty"`
+	Note           string     `json:"note,omitempty"`
+	Recipes        StringList `json:"recipes,omitempty"`
+	Manual         bool       `json:"manual"`
+	Checked        bool       `json:"checked"`
+	// CheckedBy is the user ID of the member who checked the item off.
+	CheckedBy string `json:"checkedBy,omitempty"`
+}
+
+func (l *ShoppingList) BeforeSave(tx *gorm.DB) error {
+	if !l.StartDate.IsZero() {
+		l.StartDate = AllDayDate(l.StartDate)
+	}
+	if !l.EndDate.IsZero() {
+		l.EndDate = AllDayDate(l.EndDate)
+	}
+	if l.EndDate.Before(l.StartDate) {
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidShoppingList)
+	}
+	for i := range l.Items {
+		l.Items[i].Position = i
+	}
+	return nil
+}
+
+func (i *ShoppingItem) BeforeSave(tx *gorm.DB) error {
+	if strings.TrimSpace(i.Name) == "" {
+		return fmt.Errorf("%w: items need a name", ErrInvalidShoppingList)
+	}
+	if i.Quantity < 0 {
+		return fmt.Errorf("%w: item %q has a negative quantity", ErrInvalidShoppingList, i.Name)
+	}
+	if !i.Checked {
+		i.CheckedBy = ""
+	}
+	return nil
+}
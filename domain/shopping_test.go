@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShoppingList_BeforeSave(t *testing.T) {
+	start := time.Date(2025, 5, 5, 22, 0, 0, 0, time.FixedZone("EST", -5*60*60))
+	l := ShoppingList{StartDate: start, EndDate: start.AddDate(0, 0, 7), Items: []ShoppingItem{{Name: "eggs", Position: 3}, {Name: "milk"}}}
+	if err := l.BeforeSave(nil); err != nil {
+		t.Fatalf("BeforeSave: %v", err)
+	}
+	if want := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC); !l.StartDate.Equal(want) {
+		t.Errorf("Expected %v, got %v", want, l.StartDate)
+	}
+	if l.Items[0].Position != 0 || l.Items[1].Position != 1 {
+		t.Errorf("Expected positions in list order, got %+v", l.Items)
+	}
+
+	l.EndDate = start.AddDate(0, 0, -1)
+	if err := l.BeforeSave(nil); !errors.Is(err, ErrInvalidShoppingList) {
+		t.Errorf("Expected ErrInvalidShoppingList, got %v", err)
+	}
+}
+
+func TestShoppingItem_BeforeSave(t *testing.T) {
+	item := ShoppingItem{Name: "eggs", CheckedBy: "u1"}
+	if err := item.BeforeSave(nil); err != nil || item.CheckedBy != "" {
+		t.Errorf("Expected an unchecked item to lose CheckedBy, got %q, %v", item.CheckedBy, err)
+	}
+	for name, item := range map[string]ShoppingItem{
+		"no name":           {},
+		"negative quantity": {Name: "eggs", Quantity: -1},
+	} {
+		if err := item.BeforeSave(nil); !errors.Is(err, ErrInvalidShoppingList) {
+			t.Errorf("%s: expected ErrInvalidShoppingList, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package ical
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/logger"
+	"github.com/hmlylab/common/repository"
+)
+
+// DefaultFeedHistory is how far back a feed includes events.
+const DefaultFeedHistory = 365 * 24 * time.Hour
+
+// EventFinder is the part of repository.EventRepository a feed needs.
+type EventFinder interface {
+	Find(ctx context.Context, filter repository.EventFilter) ([]domain.Event, error)
+}
+
+// FeedToken returns the token that unlocks householdID's feed. It is an
+// HMAC of the household ID under secret, so rotating secret revokes every
+// feed URL.
+func FeedToken(secret []byte, householdID string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("ical-feed:" + householdID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// FeedHandler serves a household's events as an iCalendar subscription. It
+// is meant for a route such as
+//
+//	GET /v1/households/{household_id}/calendar.ics
+//
+// and falls back to the household_id query parameter. Calendar apps cannot
+// send headers, so the token is read from the token query parameter, or
+// else from an Authorization: Bearer header. Responses carry an ETag and a
+// Last-Modified date, and conditional requests are answered with 304.
+type FeedHandler struct {
+	Events EventFinder
+	Secret []byte
+	// History is how far back events are included; zero means
+	// DefaultFeedHistory. Recurring series that started earlier are always
+	// included.
+	History time.Duration
+	// Calendar, if set, fills in the calendar's name, attendees and time
+	// zone for a household. Events is overwritten.
+	Calendar func(ctx context.Context, householdID string) (Calendar, error)
+
+	now func() time.Time
+}
+
+func NewFeedHandler(events EventFinder, secret []byte) *FeedHandler {
+	return &FeedHandler{Events: events, Secret: secret}
+}
+
+func (h *FeedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	householdID := r.PathValue("household_id")
+	if householdID == "" {
+		householdID = r.URL.Query().Get("household_id")
+	}
+	if householdID == "" {
+		http.Error(w, "household_id is required", http.StatusBadRequest)
+		return
+	}
+	if !h.authorized(r, householdID) {
+		http.Error(w, "invalid feed token", http.StatusUnauthorized)
+		return
+	}
+
+	now := time.Now
+	if h.now != nil {
+		now = h.now
+	}
+	history := h.History
+	if history == 0 {
+		history = DefaultFeedHistory
+	}
+	events, err := h.Events.Find(ctx, repository.EventFilter{HouseholdID: householdID, From: now().Add(-history)})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, "Failed to load events for calendar feed", "household_id", householdID, "error", err)
+		http.Error(w, "failed to load events", http.StatusInternalServerError)
+		return
+	}
+	var cal Calendar
+	if h.Calendar != nil {
+		if cal, err = h.Calendar(ctx, householdID); err != nil {
+			logger.Default().ErrorContext(ctx, "Failed to load calendar details for feed", "household_id", householdID, "error", err)
+			http.Error(w, "failed to load calendar", http.StatusInternalServerError)
+			return
+		}
+	}
+	cal.Events = events
+	body, err := Marshal(cal)
+	if err != nil {
+		logger.Default().ErrorContext(ctx, "Failed to encode calendar feed", "household_id", householdID, "error", err)
+		http.Error(w, "failed to encode calendar", http.StatusInternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	http.ServeContent(w, r, "calendar.ics", lastModified(events), bytes.NewReader(body))
+}
+
+func (h *FeedHandler) authorized(r *http.Request, householdID string) bool {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		token, _ = strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	}
+	if token == "" || len(h.Secret) == 0 {
+		return false
+	}
+	return hmac.Equal([]byte(token), []byte(FeedToken(h.Secret, householdID)))
+}
+
+// lastModified is the latest change among events. A deleted event does not
+// move it, but changes the ETag.
+func lastModified(events []domain.Event) time.Time {
+	var latest time.Time
+	for _, e := range events {
+		if e.UpdatedAt.After(latest) {
+			latest = e.UpdatedAt
+		}
+	}
+	return latest
+}
@@ -0,0 +1,138 @@
+package ical
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+)
+
+type fakeFinder struct {
+	events []domain.Event
+	err    error
+	filter repository.EventFilter
+}
+
+func (f *fakeFinder) Find(_ context.Context, filter repository.EventFilter) ([]domain.Event, error) {
+	f.filter = filter
+	return f.events, f.err
+}
+
+func newFeedServer(t *testing.T, finder *fakeFinder) *httptest.Server {
+	h := NewFeedHandler(finder, []byte("s3cret"))
+	h.now = func() time.Time { return at(20, 0) }
+	h.Calendar = func(_ context.Context, householdID string) (Calendar, error) {
+		return Calendar{Name: "Household " + householdID}, nil
+	}
+	mux := http.NewServeMux()
+	mux.Handle("GET /v1/households/{household_id}/calendar.ics", h)
+	mux.Handle("GET /calendar.ics", h)
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func get(t *testing.T, url string, header map[string]string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestFeedHandler(t *testing.T) {
+	finder := &fakeFinder{events: []domain.Event{
+		{BaseModel: domain.BaseModel{ID: "a", UpdatedAt: at(3, 10)}, Name: "A", StartDate: at(21, 9), EndDate: at(21, 10)},
+		{BaseModel: domain.BaseModel{ID: "b", UpdatedAt: at(4, 10)}, Name: "B", StartDate: at(22, 9), EndDate: at(22, 10)},
+	}}
+	srv := newFeedServer(t, finder)
+	url := srv.URL + "/v1/households/h1/calendar.ics?token=" + FeedToken([]byte("s3cret"), "h1")
+
+	resp := get(t, url, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Status = %d", resp.StatusCode)
+	}
+	if resp.Header.Get("Content-Type") != ContentType {
+		t.Errorf("Content-Type = %q", resp.Header.Get("Content-Type"))
+	}
+	if resp.Header.Get("Last-Modified") != at(4, 10).Format(http.TimeFormat) {
+		t.Errorf("Last-Modified = %q", resp.Header.Get("Last-Modified"))
+	}
+	etag := resp.Header.Get("ETag")
+	if etag == "" {
+		t.Error("Expected an ETag")
+	}
+	body, _ := io.ReadAll(resp.Body)
+	for _, want := range []string{"X-WR-CALNAME:Household h1", "UID:a@hmly", "UID:b@hmly"} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Expected %q in the feed", want)
+		}
+	}
+	if finder.filter.HouseholdID != "h1" || !finder.filter.From.Equal(at(20, 0).Add(-DefaultFeedHistory)) {
+		t.Errorf("Unexpected filter: %+v", finder.filter)
+	}
+
+	if resp := get(t, url, map[string]string{"If-None-Match": etag}); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected 304 for a matching ETag, got %d", resp.StatusCode)
+	}
+	if resp := get(t, url, map[string]string{"If-Modified-Since": at(5, 0).Format(http.TimeFormat)}); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Expected 304 when not modified since, got %d", resp.StatusCode)
+	}
+	if resp := get(t, url, map[string]string{"If-None-Match": `"stale"`}); resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 for a stale ETag, got %d", resp.StatusCode)
+	}
+}
+
+func TestFeedHandler_Auth(t *testing.T) {
+	srv := newFeedServer(t, &fakeFinder{})
+	token := FeedToken([]byte("s3cret"), "h1")
+
+	tests := []struct {
+		name   string
+		url    string
+		header map[string]string
+		status int
+	}{
+		{"no token", "/v1/households/h1/calendar.ics", nil, http.StatusUnauthorized},
+		{"other household's token", "/v1/households/h2/calendar.ics?token=" + token, nil, http.StatusUnauthorized},
+		{"wrong secret", "/v1/households/h1/calendar.ics?token=" + FeedToken([]byte("other"), "h1"), nil, http.StatusUnauthorized},
+		{"bearer header", "/v1/households/h1/calendar.ics", map[string]string{"Authorization": "Bearer " + token}, http.StatusOK},
+		{"query household", "/calendar.ics?household_id=h1&token=" + token, nil, http.StatusOK},
+		{"no household", "/calendar.ics?token=" + token, nil, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if resp := get(t, srv.URL+tt.url, tt.header); resp.StatusCode != tt.status {
+				t.Errorf("Status = %d, want %d", resp.StatusCode, tt.status)
+			}
+		})
+	}
+
+	h := NewFeedHandler(&fakeFinder{}, nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/calendar.ics?household_id=h1&token="+FeedToken(nil, "h1"), nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected a handler without a secret to refuse every token, got %d", rec.Code)
+	}
+}
+
+func TestFeedHandler_FindError(t *testing.T) {
+	srv := newFeedServer(t, &fakeFinder{err: errors.New("db down")})
+	resp := get(t, srv.URL+"/v1/households/h1/calendar.ics?token="+FeedToken([]byte("s3cret"), "h1"), nil)
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Status = %d, want 500", resp.StatusCode)
+	}
+}
@@ -0,0 +1,229 @@
+// Package ical renders events as RFC 5545 iCalendar data for calendar apps and
+// imports events from it.
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+const (
+	ProdID      = "-//hmlylab//hmly//EN"
+	ContentType = "text/calendar; charset=utf-8"
+
+	dateTimeLayout  = "20060102T150405Z"
+	localTimeLayout = "20060102T150405"
+	dateLayout      = "20060102"
+	maxLineOctets   = 75
+)
+
+// Attendee is how an assignee appears in ATTENDEE.
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// Calendar is a set of events to encode.
+type Calendar struct {
+	// Name is shown by calendar apps as the calendar's title.
+	Name   string
+	Events []domain.Event
+	// UIDDomain is appended to event IDs to make globally unique UIDs. It
+	// defaults to "hmly".
+	UIDDomain string
+	// Attendees maps AssignedTo user IDs to the people they name. Assignees
+	// not in the map are identified by user ID only.
+	Attendees map[string]Attendee
+	// Location is the household's time zone. Timed series repeat at the same
+	// local time across DST changes, so they and their overrides are written
+	// in it with a TZID. Nil or UTC writes them in UTC.
+	Location *time.Location
+}
+
+// Marshal encodes cal as an iCalendar object.
+func Marshal(cal Calendar) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Encode(&buf, cal); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Encode writes cal as an iCalendar object. Timed events are written in UTC,
+// except series and their overrides when cal has a Location, and all-day
+// events as dates. An event that overrides an occurrence shares its series'
+// UID and carries a RECURRENCE-ID.
+func Encode(w io.Writer, cal Calendar) error {
+	e := &encoder{w: w}
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	e.line("METHOD", "PUBLISH")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+	if year, ok := firstLocalYear(cal); ok {
+		e.timezone(cal.Location, year)
+	}
+	for _, event := range cal.Events {
+		if err := e.event(cal, event); err != nil {
+			return err
+		}
+	}
+	e.line("END", "VCALENDAR")
+	return e.err
+}
+
+type encoder struct {
+	w   io.Writer
+	err error
+}
+
+func (e *encoder) event(cal Calendar, ev domain.Event) error {
+	uidDomain := cal.UIDDomain
+	if uidDomain == "" {
+		uidDomain = "hmly"
+	}
+	uid := ev.ID
+	if ev.IsOverride() {
+		uid = ev.SeriesID
+	}
+
+	e.line("BEGIN", "VEVENT")
+	e.line("UID", escapeText(uid+"@"+uidDomain))
+	stamp := ev.UpdatedAt
+	if stamp.IsZero() {
+		stamp = ev.CreatedAt
+	}
+	e.line("DTSTAMP", stamp.UTC().Format(dateTimeLayout))
+	loc := zone(cal, ev)
+	e.line(timeProp("DTSTART", ev.AllDay, loc), formatTime(ev.StartDate, ev.AllDay, loc))
+	e.line(timeProp("DTEND", ev.AllDay, loc), formatTime(ev.EndDate, ev.AllDay, loc))
+	if ev.IsOverride() {
+		e.line(timeProp("RECURRENCE-ID", ev.AllDay, loc), formatTime(*ev.RecurrenceID, ev.AllDay, loc))
+	}
+	if ev.IsRecurring() {
+		rule, err := ev.Recurrence()
+		if err != nil {
+			return fmt.Errorf("event %s: %w", ev.ID, err)
+		}
+		e.line("RRULE", formatRule(rule, ev.AllDay))
+		if len(ev.ExDates) > 0 {
+			dates := make([]string, len(ev.ExDates))
+			for i, t := range ev.ExDates {
+				dates[i] = formatTime(t, ev.AllDay, loc)
+			}
+			e.line(timeProp("EXDATE", ev.AllDay, loc), strings.Join(dates, ","))
+		}
+	}
+	e.line("SUMMARY", escapeText(ev.Name))
+	if ev.EntityType != "" {
+		e.line("CATEGORIES", escapeText(ev.EntityType))
+	}
+	if ev.AssignedTo != "" {
+		e.line(attendee(ev.AssignedTo, cal.Attendees[ev.AssignedTo]))
+	}
+	if !ev.CreatedAt.IsZero() {
+		e.line("CREATED", ev.CreatedAt.UTC().Format(dateTimeLayout))
+	}
+	if !ev.UpdatedAt.IsZero() {
+		e.line("LAST-MODIFIED", ev.UpdatedAt.UTC().Format(dateTimeLayout))
+	}
+	e.line("END", "VEVENT")
+	return nil
+}
+
+// zone returns the zone ev's times are written in, or nil for UTC.
+func zone(cal Calendar, ev domain.Event) *time.Location {
+	if ev.AllDay || cal.Location == nil || cal.Location == time.UTC || !(ev.IsRecurring() || ev.IsOverride()) {
+		return nil
+	}
+	return cal.Location
+}
+
+func timeProp(name string, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE"
+	case loc != nil:
+		return name + ";TZID=" + loc.String()
+	}
+	return name
+}
+
+func formatTime(t time.Time, allDay bool, loc *time.Location) string {
+	switch {
+	case allDay:
+		return t.UTC().Format(dateLayout)
+	case loc != nil:
+		return t.In(loc).Format(localTimeLayout)
+	}
+	return t.UTC().Format(dateTimeLayout)
+}
+
+// formatRule writes UNTIL as a date for all-day events, as RFC 5545 requires
+// UNTIL to match DTSTART's value type.
+func formatRule(rule domain.RecurrenceRule, allDay bool) string {
+	s := rule.String()
+	if allDay && !rule.Until.IsZero() {
+		s = strings.Replace(s, "UNTIL="+rule.Until.UTC().Format(dateTimeLayout), "UNTIL="+rule.Until.UTC().Format(dateLayout), 1)
+	}
+	return s
+}
+
+func attendee(userID string, a Attendee) (string, string) {
+	name := "ATTENDEE"
+	if a.Name != "" {
+		name += ";CN=" + quoteParam(a.Name)
+	}
+	if a.Email != "" {
+		return name, "mailto:" + a.Email
+	}
+	return name, "urn:hmly:user:" + userID
+}
+
+// line writes a content line, folded at 75 octets without splitting UTF-8
+// sequences.
+func (e *encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+	s := name + ":" + value
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		n := len(string(r))
+		if width+n > maxLineOctets {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += n
+	}
+	b.WriteString("\r\n")
+	_, e.err = io.WriteString(e.w, b.String())
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// quoteParam quotes a parameter value; double quotes cannot be escaped, so
+// they are dropped.
+func quoteParam(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if r == '"' || r < ' ' {
+			return -1
+		}
+		return r
+	}, s)
+	return `"` + s + `"`
+}
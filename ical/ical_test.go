@@ -0,0 +1,180 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+func at(d, h int) time.Time {
+	return time.Date(2025, 5, d, h, 0, 0, 0, time.UTC)
+}
+
+func TestMarshal(t *testing.T) {
+	rid := at(12, 19)
+	cal := Calendar{
+		Name: "Smith, Family",
+		Events: []domain.Event{
+			{
+				BaseModel:  domain.BaseModel{ID: "bins", CreatedAt: at(1, 8), UpdatedAt: at(2, 8)},
+				Name:       "Take out bins; recycling too",
+				EntityType: "chore",
+				StartDate:  at(5, 19), EndDate: at(5, 20),
+				AssignedTo: "user_1",
+				RRule:      "FREQ=WEEKLY;BYDAY=MO;UNTIL=20250630T190000Z",
+				ExDates:    domain.TimeList{at(19, 19)},
+			},
+			{
+				BaseModel: domain.BaseModel{ID: "moved", UpdatedAt: at(3, 8)},
+				Name:      "Take out bins",
+				StartDate: at(13, 19), EndDate: at(13, 20),
+				SeriesID:     "bins",
+				RecurrenceID: &rid,
+			},
+			{
+				BaseModel: domain.BaseModel{ID: "holiday", UpdatedAt: at(3, 8)},
+				Name:      "Bank holiday",
+				AllDay:    true,
+				StartDate: at(26, 0), EndDate: at(27, 0),
+				AssignedTo: "user_2",
+			},
+		},
+		Attendees: map[string]Attendee{"user_1": {Name: `Sam "the bin" Smith`, Email: "sam@example.com"}},
+	}
+
+	got, err := Marshal(cal)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//hmlylab//hmly//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Smith\, Family`,
+		"BEGIN:VEVENT",
+		"UID:bins@hmly",
+		"DTSTAMP:20250502T080000Z",
+		"DTSTART:20250505T190000Z",
+		"DTEND:20250505T200000Z",
+		"RRULE:FREQ=WEEKLY;UNTIL=20250630T190000Z;BYDAY=MO",
+		"EXDATE:20250519T190000Z",
+		`SUMMARY:Take out bins\; recycling too`,
+		"CATEGORIES:chore",
+		`ATTENDEE;CN="Sam the bin Smith":mailto:sam@example.com`,
+		"CREATED:20250501T080000Z",
+		"LAST-MODIFIED:20250502T080000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:bins@hmly",
+		"DTSTAMP:20250503T080000Z",
+		"DTSTART:20250513T190000Z",
+		"DTEND:20250513T200000Z",
+		"RECURRENCE-ID:20250512T190000Z",
+		"SUMMARY:Take out bins",
+		"LAST-MODIFIED:20250503T080000Z",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:holiday@hmly",
+		"DTSTAMP:20250503T080000Z",
+		"DTSTART;VALUE=DATE:20250526",
+		"DTEND;VALUE=DATE:20250527",
+		"SUMMARY:Bank holiday",
+		"ATTENDEE:urn:hmly:user:user_2",
+		"LAST-MODIFIED:20250503T080000Z",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if string(got) != want {
+		t.Errorf("Marshal() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestMarshal_AllDayUntil(t *testing.T) {
+	got, err := Marshal(Calendar{Events: []domain.Event{{
+		BaseModel: domain.BaseModel{ID: "e"},
+		AllDay:    true,
+		StartDate: at(1, 0), EndDate: at(2, 0),
+		RRule:   "FREQ=DAILY;UNTIL=20250510",
+		ExDates: domain.TimeList{at(3, 0)},
+	}}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"\r\nRRULE:FREQ=DAILY;UNTIL=20250510\r\n", "\r\nEXDATE;VALUE=DATE:20250503\r\n"} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Expected %q in\n%s", want, got)
+		}
+	}
+}
+
+func TestMarshal_TimeZone(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	rid := at(12, 18)
+	cal := Calendar{Location: london, Events: []domain.Event{
+		{BaseModel: domain.BaseModel{ID: "bins"}, StartDate: at(5, 18), EndDate: at(5, 19), RRule: "FREQ=WEEKLY;BYDAY=MO", ExDates: domain.TimeList{at(19, 18)}},
+		{BaseModel: domain.BaseModel{ID: "moved"}, StartDate: at(13, 18), EndDate: at(13, 19), SeriesID: "bins", RecurrenceID: &rid},
+		{BaseModel: domain.BaseModel{ID: "once"}, StartDate: at(6, 18), EndDate: at(6, 19)},
+	}}
+	got, err := Marshal(cal)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"\r\nBEGIN:VTIMEZONE\r\nTZID:Europe/London\r\n",
+		"\r\nBEGIN:DAYLIGHT\r\nDTSTART:20250330T010000\r\nRRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=-1SU\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\nTZNAME:BST\r\n",
+		"\r\nBEGIN:STANDARD\r\nDTSTART:20251026T020000\r\nRRULE:FREQ=YEARLY;BYMONTH=10;BYDAY=-1SU\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0000\r\n",
+		"\r\nDTSTART;TZID=Europe/London:20250505T190000\r\nDTEND;TZID=Europe/London:20250505T200000\r\n",
+		"\r\nEXDATE;TZID=Europe/London:20250519T190000\r\n",
+		"\r\nRECURRENCE-ID;TZID=Europe/London:20250512T190000\r\n",
+		"\r\nDTSTART:20250506T180000Z\r\n",
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("Expected %q in\n%s", want, got)
+		}
+	}
+
+	parsed, err := Parse(strings.NewReader(string(got)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Events) != 3 || !parsed.Events[0].StartDate.Equal(at(5, 18)) || !parsed.Events[1].RecurrenceID.Equal(rid) {
+		t.Errorf("parsed = %+v", parsed)
+	}
+}
+
+func TestMarshal_InvalidRule(t *testing.T) {
+	_, err := Marshal(Calendar{Events: []domain.Event{{BaseModel: domain.BaseModel{ID: "e"}, RRule: "FREQ=SOMETIMES"}}})
+	if err == nil || !strings.Contains(err.Error(), "event e") {
+		t.Errorf("Expected an invalid rule error, got %v", err)
+	}
+}
+
+func TestEncoder_Folding(t *testing.T) {
+	name := strings.Repeat("ü", 60) + "\nnext line"
+	got, err := Marshal(Calendar{Name: name})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var unfolded strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(string(got), "\r\n"), "\r\n") {
+		if len(line) > maxLineOctets {
+			t.Errorf("Line of %d octets: %q", len(line), line)
+		}
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+			continue
+		}
+		unfolded.WriteString("\n" + line)
+	}
+	if !strings.Contains(unfolded.String(), "\nX-WR-CALNAME:"+strings.Repeat("ü", 60)+`\nnext line`) {
+		t.Errorf("Expected the name to unfold intact, got %q", unfolded.String())
+	}
+}
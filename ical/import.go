@@ -0,0 +1,185 @@
+package ical
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+)
+
+var ErrMissingSeries = errors.New("overridden series not found")
+
+// EventStore is the part of repository.EventRepository an import needs.
+type EventStore interface {
+	EventFinder
+	Create(ctx context.Context, event *domain.Event) (*domain.Event, error)
+	Update(ctx context.Context, id string, event *domain.Event) (*domain.Event, error)
+}
+
+// ImportOptions says where imported events go.
+type ImportOptions struct {
+	HouseholdID string
+	// EntityType and EntityID default to the household.
+	EntityType string
+	EntityID   string
+	// Location is the zone for floating times; nil is UTC.
+	Location *time.Location
+	// DryRun reports the changes without saving them.
+	DryRun bool
+}
+
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+)
+
+// Change is what an import did, or would do, with one VEVENT.
+type Change struct {
+	Action Action
+	Event  domain.Event
+}
+
+// ImportResult reports an import.
+type ImportResult struct {
+	Created, Updated, Unchanged int
+	Changes                     []Change
+	// Problems are the VEVENTs that were skipped.
+	Problems []Problem
+}
+
+// Import reads the VEVENTs in r into events of a household. An event already
+// imported with the same UID, and for an override the same RECURRENCE-ID, is
+// updated in place, keeping its assignee; events missing from r are left
+// alone. An override whose series is neither in r nor imported earlier is
+// skipped.
+//
+// Events are saved one at a time, so a failed import may be partly applied;
+// importing again finishes it.
+func Import(ctx context.Context, store EventStore, r io.Reader, opts ImportOptions) (ImportResult, error) {
+	parsed, err := Parse(r, opts.Location)
+	if err != nil {
+		return ImportResult{}, err
+	}
+	result := ImportResult{Problems: parsed.Problems}
+	if len(parsed.Events) == 0 {
+		return result, nil
+	}
+
+	uids := make([]string, 0, len(parsed.Events))
+	for _, e := range parsed.Events {
+		if !slices.Contains(uids, e.ExternalUID) {
+			uids = append(uids, e.ExternalUID)
+		}
+	}
+	stored, err := store.Find(ctx, repository.EventFilter{HouseholdID: opts.HouseholdID, ExternalUIDs: uids})
+	if err != nil {
+		return ImportResult{}, err
+	}
+	existing := make(map[importKey]domain.Event, len(stored))
+	for _, e := range stored {
+		existing[keyOf(e)] = e
+	}
+
+	// Series are saved before their overrides so the overrides can point at
+	// them.
+	slices.SortStableFunc(parsed.Events, func(a, b domain.Event) int {
+		switch {
+		case a.RecurrenceID == nil && b.RecurrenceID != nil:
+			return -1
+		case a.RecurrenceID != nil && b.RecurrenceID == nil:
+			return 1
+		}
+		return 0
+	})
+	seriesIDs := make(map[string]string)
+	for _, e := range stored {
+		if e.RecurrenceID == nil {
+			seriesIDs[e.ExternalUID] = e.ID
+		}
+	}
+
+	for _, e := range parsed.Events {
+		e.HouseholdID = opts.HouseholdID
+		e.EntityType, e.EntityID = opts.EntityType, opts.EntityID
+		if e.EntityType == "" {
+			e.EntityType, e.EntityID = "household", opts.HouseholdID
+		}
+		if e.RecurrenceID != nil {
+			seriesID, ok := seriesIDs[e.ExternalUID]
+			if !ok {
+				result.Problems = append(result.Problems, Problem{UID: e.ExternalUID, Err: ErrMissingSeries})
+				continue
+			}
+			e.SeriesID = seriesID
+		}
+
+		change := Change{Action: ActionCreate, Event: e}
+		if old, ok := existing[keyOf(e)]; ok {
+			change = Change{Action: ActionUnchanged, Event: old}
+			if !sameEvent(old, e) {
+				updated := old
+				updated.Name, updated.StartDate, updated.EndDate, updated.AllDay = e.Name, e.StartDate, e.EndDate, e.AllDay
+				updated.RRule, updated.ExDates = e.RRule, e.ExDates
+				change = Change{Action: ActionUpdate, Event: updated}
+			}
+		}
+
+		if !opts.DryRun {
+			switch change.Action {
+			case ActionCreate:
+				created, err := store.Create(ctx, &change.Event)
+				if err != nil {
+					return result, err
+				}
+				change.Event = *created
+			case ActionUpdate:
+				if _, err := store.Update(ctx, change.Event.ID, &change.Event); err != nil {
+					return result, err
+				}
+			}
+		}
+		if e.RecurrenceID == nil {
+			// In a dry run a new series has no ID, but its overrides still
+			// count as created.
+			seriesIDs[e.ExternalUID] = change.Event.ID
+		}
+
+		switch change.Action {
+		case ActionCreate:
+			result.Created++
+		case ActionUpdate:
+			result.Updated++
+		default:
+			result.Unchanged++
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return result, nil
+}
+
+type importKey struct {
+	uid          string
+	recurrenceID int64
+}
+
+func keyOf(e domain.Event) importKey {
+	k := importKey{uid: e.ExternalUID}
+	if e.RecurrenceID != nil {
+		k.recurrenceID = e.RecurrenceID.Unix()
+	}
+	return k
+}
+
+// sameEvent compares the fields an import sets.
+func sameEvent(a, b domain.Event) bool {
+	return a.Name == b.Name && a.StartDate.Equal(b.StartDate) && a.EndDate.Equal(b.EndDate) &&
+		a.AllDay == b.AllDay && a.RRule == b.RRule &&
+		slices.EqualFunc(a.ExDates, b.ExDates, time.Time.Equal)
+}
@@ -0,0 +1,159 @@
+package ical
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newEventStore(t *testing.T) repository.EventRepository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&domain.Event{}); err != nil {
+		t.Fatal(err)
+	}
+	return repository.NewEventRepository(db)
+}
+
+const importSample = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:swim@example.com\r\n" +
+	"SUMMARY:Swim\r\n" +
+	"DTSTART:20250505T090000Z\r\n" +
+	"DTEND:20250505T100000Z\r\n" +
+	"RRULE:FREQ=WEEKLY\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:swim@example.com\r\n" +
+	"RECURRENCE-ID:20250512T090000Z\r\n" +
+	"SUMMARY:Swim (late)\r\n" +
+	"DTSTART:20250512T110000Z\r\n" +
+	"DTEND:20250512T120000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:dentist@example.com\r\n" +
+	"SUMMARY:Dentist\r\n" +
+	"DTSTART:20250507T140000Z\r\n" +
+	"DTEND:20250507T143000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:orphan@example.com\r\n" +
+	"RECURRENCE-ID:20250512T090000Z\r\n" +
+	"DTSTART:20250512T110000Z\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	store := newEventStore(t)
+	opts := ImportOptions{HouseholdID: "h1"}
+
+	dry, err := Import(ctx, store, strings.NewReader(importSample), ImportOptions{HouseholdID: "h1", DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dry.Created != 3 || dry.Updated != 0 || len(dry.Changes) != 3 {
+		t.Errorf("dry run = %+v", dry)
+	}
+	if len(dry.Problems) != 1 || dry.Problems[0].UID != "orphan@example.com" || !errors.Is(dry.Problems[0].Err, ErrMissingSeries) {
+		t.Errorf("dry run problems = %v", dry.Problems)
+	}
+	if stored, _ := store.Find(ctx, repository.EventFilter{}); len(stored) != 0 {
+		t.Fatalf("dry run stored %d events", len(stored))
+	}
+
+	result, err := Import(ctx, store, strings.NewReader(importSample), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != 3 {
+		t.Errorf("import = %+v", result)
+	}
+	stored, err := store.Find(ctx, repository.EventFilter{HouseholdID: "h1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 3 {
+		t.Fatalf("stored %d events, want 3", len(stored))
+	}
+	var series, override domain.Event
+	for _, e := range stored {
+		if e.EntityType != "household" || e.EntityID != "h1" {
+			t.Errorf("%s belongs to %s %s", e.Name, e.EntityType, e.EntityID)
+		}
+		switch e.Name {
+		case "Swim":
+			series = e
+		case "Swim (late)":
+			override = e
+		}
+	}
+	if override.SeriesID == "" || override.SeriesID != series.ID {
+		t.Errorf("override SeriesID = %q, series ID = %q", override.SeriesID, series.ID)
+	}
+
+	// The household assigns the series, then the source calendar moves the
+	// dentist.
+	series.AssignedTo = "u1"
+	if _, err := store.Update(ctx, series.ID, &series); err != nil {
+		t.Fatal(err)
+	}
+	changed := strings.Replace(importSample, "DTSTART:20250507T140000Z\r\nDTEND:20250507T143000Z", "DTSTART:20250508T140000Z\r\nDTEND:20250508T143000Z", 1)
+
+	dry, err = Import(ctx, store, strings.NewReader(changed), ImportOptions{HouseholdID: "h1", DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dry.Created != 0 || dry.Updated != 1 || dry.Unchanged != 2 {
+		t.Errorf("dry run after change = %+v", dry)
+	}
+
+	result, err = Import(ctx, store, strings.NewReader(changed), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Created != 0 || result.Updated != 1 || result.Unchanged != 2 {
+		t.Errorf("re-import = %+v", result)
+	}
+	stored, _ = store.Find(ctx, repository.EventFilter{HouseholdID: "h1"})
+	if len(stored) != 3 {
+		t.Fatalf("re-import left %d events, want 3", len(stored))
+	}
+	for _, e := range stored {
+		switch e.Name {
+		case "Dentist":
+			if want := time.Date(2025, 5, 8, 14, 0, 0, 0, time.UTC); !e.StartDate.Equal(want) {
+				t.Errorf("dentist starts %v, want %v", e.StartDate, want)
+			}
+		case "Swim":
+			if e.AssignedTo != "u1" {
+				t.Errorf("re-import cleared the assignee")
+			}
+		}
+	}
+
+	// Another household gets its own copy.
+	other, err := Import(ctx, store, strings.NewReader(importSample), ImportOptions{HouseholdID: "h2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Created != 3 {
+		t.Errorf("import into h2 = %+v", other)
+	}
+}
+
+func TestImport_Malformed(t *testing.T) {
+	_, err := Import(context.Background(), newEventStore(t), strings.NewReader("not a calendar"), ImportOptions{HouseholdID: "h1"})
+	if !errors.Is(err, ErrMalformed) {
+		t.Errorf("err = %v, want ErrMalformed", err)
+	}
+}
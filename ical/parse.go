@@ -0,0 +1,345 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+var ErrMalformed = fmt.Errorf("%w: malformed iCalendar data", domain.ErrInvalidArgument)
+
+// Problem is a VEVENT that could not be read.
+type Problem struct {
+	UID  string
+	Line int
+	Err  error
+}
+
+func (p Problem) Error() string {
+	if p.UID == "" {
+		return fmt.Sprintf("line %d: %v", p.Line, p.Err)
+	}
+	return fmt.Sprintf("line %d: event %s: %v", p.Line, p.UID, p.Err)
+}
+
+// Parsed is the result of Parse.
+type Parsed struct {
+	// Events have ExternalUID set to the VEVENT's UID. An event with
+	// RecurrenceID set overrides one occurrence of the series with the same
+	// UID; its SeriesID is left for the caller to fill in.
+	Events []domain.Event
+	// Problems lists the VEVENTs that were skipped.
+	Problems []Problem
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+	line   int
+}
+
+// Parse reads the VEVENTs of an iCalendar object. Times with a TZID are read
+// in that IANA zone and floating times in loc, or UTC if loc is nil. Dates
+// make all-day events. Cancelled events are dropped, and a cancelled
+// occurrence becomes an EXDATE of its series. VEVENTs using features events
+// do not support, such as YEARLY rules, are reported in Problems.
+func Parse(r io.Reader, loc *time.Location) (Parsed, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	props, err := readProperties(r)
+	if err != nil {
+		return Parsed{}, err
+	}
+
+	var (
+		parsed    Parsed
+		depth     []string
+		event     []property
+		cancelled = make(map[string][]time.Time)
+		series    = make(map[string]int)
+	)
+	for _, p := range props {
+		switch p.name {
+		case "BEGIN":
+			depth = append(depth, strings.ToUpper(p.value))
+			if len(depth) == 1 && depth[0] != "VCALENDAR" {
+				return Parsed{}, fmt.Errorf("%w: line %d: expected BEGIN:VCALENDAR", ErrMalformed, p.line)
+			}
+			if strings.EqualFold(p.value, "VEVENT") {
+				event = []property{p}
+			}
+			continue
+		case "END":
+			if len(depth) == 0 || depth[len(depth)-1] != strings.ToUpper(p.value) {
+				return Parsed{}, fmt.Errorf("%w: line %d: unexpected END:%s", ErrMalformed, p.line, p.value)
+			}
+			depth = depth[:len(depth)-1]
+			if strings.EqualFold(p.value, "VEVENT") {
+				e, status, err := toEvent(event, loc)
+				switch {
+				case err != nil:
+					parsed.Problems = append(parsed.Problems, Problem{UID: e.ExternalUID, Line: event[0].line, Err: err})
+				case strings.EqualFold(status, "CANCELLED"):
+					if e.RecurrenceID != nil {
+						cancelled[e.ExternalUID] = append(cancelled[e.ExternalUID], *e.RecurrenceID)
+					}
+				default:
+					if !e.IsRecurring() || e.RecurrenceID != nil {
+						parsed.Events = append(parsed.Events, e)
+						break
+					}
+					series[e.ExternalUID] = len(parsed.Events)
+					parsed.Events = append(parsed.Events, e)
+				}
+				event = nil
+			}
+			continue
+		}
+		// Properties of components nested in a VEVENT, like VALARM, are
+		// skipped.
+		if event != nil && depth[len(depth)-1] == "VEVENT" {
+			event = append(event, p)
+		}
+	}
+	if len(depth) > 0 {
+		return Parsed{}, fmt.Errorf("%w: missing END:%s", ErrMalformed, depth[len(depth)-1])
+	}
+
+	for uid, starts := range cancelled {
+		if i, ok := series[uid]; ok {
+			parsed.Events[i].ExDates = append(parsed.Events[i].ExDates, starts...)
+		}
+	}
+	return parsed, nil
+}
+
+// readProperties unfolds the content lines of r and splits them into
+// properties.
+func readProperties(r io.Reader) ([]property, error) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var (
+		props   []property
+		current strings.Builder
+		start   int
+	)
+	flush := func() error {
+		if current.Len() == 0 {
+			return nil
+		}
+		p, err := parseProperty(current.String())
+		if err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrMalformed, start, err)
+		}
+		p.line = start
+		props = append(props, p)
+		current.Reset()
+		return nil
+	}
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSuffix(sc.Text(), "\r")
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			current.WriteString(line[1:])
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+		if line != "" {
+			current.WriteString(line)
+			start = n
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+func parseProperty(line string) (property, error) {
+	p := property{params: make(map[string]string)}
+	// The name and parameters end at the first colon outside quotes.
+	inQuotes := false
+	end := -1
+	for i, r := range line {
+		if r == '"' {
+			inQuotes = !inQuotes
+		} else if r == ':' && !inQuotes {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return p, fmt.Errorf("no colon in %q", line)
+	}
+	head, value := line[:end], line[end+1:]
+	parts := splitUnquoted(head, ';')
+	p.name = strings.ToUpper(parts[0])
+	for _, param := range parts[1:] {
+		k, v, _ := strings.Cut(param, "=")
+		p.params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+	p.value = value
+	return p, nil
+}
+
+func splitUnquoted(s string, sep rune) []string {
+	var parts []string
+	inQuotes := false
+	last := 0
+	for i, r := range s {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == sep && !inQuotes:
+			parts = append(parts, s[last:i])
+			last = i + 1
+		}
+	}
+	return append(parts, s[last:])
+}
+
+// toEvent maps a VEVENT's properties to an event, returning its STATUS too.
+func toEvent(props []property, loc *time.Location) (domain.Event, string, error) {
+	var (
+		e        domain.Event
+		status   string
+		start    *property
+		end      *property
+		duration string
+	)
+	for i := range props {
+		p := &props[i]
+		switch p.name {
+		case "UID":
+			e.ExternalUID = p.value
+		case "SUMMARY":
+			e.Name = unescapeText(p.value)
+		case "STATUS":
+			status = p.value
+		case "DTSTART":
+			start = p
+		case "DTEND":
+			end = p
+		case "DURATION":
+			duration = p.value
+		case "RRULE":
+			if e.RRule != "" {
+				return e, status, errors.New("more than one RRULE")
+			}
+			rule, err := domain.ParseRRule(p.value)
+			if err != nil {
+				return e, status, err
+			}
+			e.RRule = rule.String()
+		case "EXDATE":
+			for _, v := range strings.Split(p.value, ",") {
+				t, _, err := parseTime(v, p.params, loc)
+				if err != nil {
+					return e, status, fmt.Errorf("EXDATE: %w", err)
+				}
+				e.ExDates = append(e.ExDates, t)
+			}
+		case "RECURRENCE-ID":
+			t, _, err := parseTime(p.value, p.params, loc)
+			if err != nil {
+				return e, status, fmt.Errorf("RECURRENCE-ID: %w", err)
+			}
+			e.RecurrenceID = &t
+		case "RDATE":
+			return e, status, errors.New("RDATE is not supported")
+		}
+	}
+	if e.ExternalUID == "" {
+		return e, status, errors.New("UID is required")
+	}
+	if start == nil {
+		return e, status, errors.New("DTSTART is required")
+	}
+
+	var err error
+	e.StartDate, e.AllDay, err = parseTime(start.value, start.params, loc)
+	if err != nil {
+		return e, status, fmt.Errorf("DTSTART: %w", err)
+	}
+	switch {
+	case end != nil:
+		if e.EndDate, _, err = parseTime(end.value, end.params, loc); err != nil {
+			return e, status, fmt.Errorf("DTEND: %w", err)
+		}
+	case duration != "":
+		d, err := parseDuration(duration)
+		if err != nil {
+			return e, status, fmt.Errorf("DURATION: %w", err)
+		}
+		e.EndDate = e.StartDate.Add(d)
+	case e.AllDay:
+		e.EndDate = e.StartDate.AddDate(0, 0, 1)
+	default:
+		e.EndDate = e.StartDate
+	}
+	if e.EndDate.Before(e.StartDate) {
+		return e, status, errors.New("DTEND is before DTSTART")
+	}
+	return e, status, nil
+}
+
+// parseTime reads a DATE or DATE-TIME value, reporting whether it was a date.
+// Dates are returned as midnight UTC, as all-day events store them.
+func parseTime(value string, params map[string]string, loc *time.Location) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.Parse(dateLayout, value)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeLayout, value)
+		return t, false, err
+	}
+	if tzid := params["TZID"]; tzid != "" {
+		zone, err := time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("unknown TZID %q", tzid)
+		}
+		loc = zone
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+var durationRe = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+func parseDuration(s string) (time.Duration, error) {
+	m := durationRe.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("%q is not a duration", s)
+	}
+	var d time.Duration
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if m[i+2] != "" {
+			n, _ := strconv.Atoi(m[i+2])
+			d += time.Duration(n) * unit
+		}
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,200 @@
+package ical
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+const sample = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example//EN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Europe/London\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:19701025T020000\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:bins@example.com\r\n" +
+	"SUMMARY:Bins\\, recycling\r\n" +
+	"DTSTART;TZID=Europe/London:20250505T190000\r\n" +
+	"DURATION:PT30M\r\n" +
+	"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n" +
+	"EXDATE;TZID=Europe/London:20250512T190000,20250519T190000\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"SUMMARY:Alarm\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:bins@example.com\r\n" +
+	"RECURRENCE-ID;TZID=Europe/London:20250526T190000\r\n" +
+	"SUMMARY:Bins (bank holiday)\r\n" +
+	"DTSTART;TZID=Europe/London:20250527T190000\r\n" +
+	"DTEND;TZID=Europe/London:20250527T193000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:bins@example.com\r\n" +
+	"RECURRENCE-ID;TZID=Europe/London:20250602T190000\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"DTSTART;TZID=Europe/London:20250602T190000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:holiday@example.com\r\n" +
+	"SUMMARY:A very long summary that is folded across more than one content\r\n" +
+	"  line\r\n" +
+	"DTSTART;VALUE=DATE:20250801\r\n" +
+	"DTEND;VALUE=DATE:20250808\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:birthday@example.com\r\n" +
+	"SUMMARY:Birthday\r\n" +
+	"DTSTART;VALUE=DATE:20250601\r\n" +
+	"RRULE:FREQ=YEARLY\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	parsed, err := Parse(strings.NewReader(sample), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Events) != 3 {
+		t.Fatalf("got %d events, want 3: %+v", len(parsed.Events), parsed.Events)
+	}
+
+	bins := parsed.Events[0]
+	if bins.ExternalUID != "bins@example.com" || bins.Name != "Bins, recycling" {
+		t.Errorf("bins = %q %q", bins.ExternalUID, bins.Name)
+	}
+	start := time.Date(2025, 5, 5, 19, 0, 0, 0, london)
+	if !bins.StartDate.Equal(start) || !bins.EndDate.Equal(start.Add(30*time.Minute)) || bins.AllDay {
+		t.Errorf("bins runs %v to %v", bins.StartDate, bins.EndDate)
+	}
+	if bins.StartDate.Location().String() != "Europe/London" {
+		t.Errorf("bins starts in %v, want Europe/London", bins.StartDate.Location())
+	}
+	if bins.RRule != "FREQ=WEEKLY;BYDAY=MO" {
+		t.Errorf("RRule = %q", bins.RRule)
+	}
+	wantEx := []time.Time{start.AddDate(0, 0, 7), start.AddDate(0, 0, 14), start.AddDate(0, 0, 28)}
+	if len(bins.ExDates) != len(wantEx) {
+		t.Fatalf("ExDates = %v, want %v", bins.ExDates, wantEx)
+	}
+	for i, want := range wantEx {
+		if !bins.ExDates[i].Equal(want) {
+			t.Errorf("ExDates[%d] = %v, want %v", i, bins.ExDates[i], want)
+		}
+	}
+
+	override := parsed.Events[1]
+	if override.RecurrenceID == nil || !override.RecurrenceID.Equal(start.AddDate(0, 0, 21)) {
+		t.Errorf("RecurrenceID = %v", override.RecurrenceID)
+	}
+	if override.SeriesID != "" || override.ExternalUID != "bins@example.com" {
+		t.Errorf("override = %+v", override)
+	}
+
+	holiday := parsed.Events[2]
+	if !holiday.AllDay || !holiday.StartDate.Equal(time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)) ||
+		!holiday.EndDate.Equal(time.Date(2025, 8, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("holiday = %+v", holiday)
+	}
+	if want := "A very long summary that is folded across more than one content line"; holiday.Name != want {
+		t.Errorf("Name = %q, want %q", holiday.Name, want)
+	}
+
+	if len(parsed.Problems) != 1 || parsed.Problems[0].UID != "birthday@example.com" ||
+		!errors.Is(parsed.Problems[0].Err, domain.ErrInvalidRRule) {
+		t.Errorf("Problems = %v", parsed.Problems)
+	}
+}
+
+func TestParse_Defaults(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	ics := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART:20250505T090000\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:b\nDTSTART;VALUE=DATE:20250505\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nUID:c\nDTSTART:20250505T090000Z\nDTEND:20250505T080000Z\nEND:VEVENT\n" +
+		"BEGIN:VEVENT\nSUMMARY:no uid\nDTSTART:20250505T090000Z\nEND:VEVENT\nEND:VCALENDAR\n"
+	parsed, err := Parse(strings.NewReader(ics), tokyo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Events) != 2 {
+		t.Fatalf("got %d events, want 2", len(parsed.Events))
+	}
+	floating := parsed.Events[0]
+	if want := time.Date(2025, 5, 5, 9, 0, 0, 0, tokyo); !floating.StartDate.Equal(want) || !floating.EndDate.Equal(want) {
+		t.Errorf("floating event runs %v to %v, want %v", floating.StartDate, floating.EndDate, want)
+	}
+	if day := parsed.Events[1]; !day.AllDay || !day.EndDate.Equal(day.StartDate.AddDate(0, 0, 1)) {
+		t.Errorf("all-day event = %+v", day)
+	}
+	if len(parsed.Problems) != 2 || parsed.Problems[0].UID != "c" || parsed.Problems[1].Line != 15 {
+		t.Errorf("Problems = %v", parsed.Problems)
+	}
+}
+
+func TestParse_Malformed(t *testing.T) {
+	for name, ics := range map[string]string{
+		"not a calendar": "BEGIN:VEVENT\nEND:VEVENT\n",
+		"unclosed":       "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\n",
+		"mismatched end": "BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR\n",
+		"no colon":       "BEGIN:VCALENDAR\nSUMMARY\nEND:VCALENDAR\n",
+	} {
+		if _, err := Parse(strings.NewReader(ics), nil); !errors.Is(err, ErrMalformed) {
+			t.Errorf("%s: err = %v, want ErrMalformed", name, err)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	until := at(30, 0)
+	events := []domain.Event{
+		{BaseModel: domain.BaseModel{ID: "e1"}, Name: "Swim; then lunch", StartDate: at(5, 9), EndDate: at(5, 10), RRule: "FREQ=DAILY;INTERVAL=2;UNTIL=" + until.Format(dateTimeLayout), ExDates: domain.TimeList{at(7, 9)}},
+		{BaseModel: domain.BaseModel{ID: "e2"}, Name: "Trip", AllDay: true, StartDate: at(10, 0), EndDate: at(12, 0)},
+	}
+	body, err := Marshal(Calendar{Events: events})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := Parse(strings.NewReader(string(body)), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Events) != 2 || len(parsed.Problems) != 0 {
+		t.Fatalf("parsed = %+v", parsed)
+	}
+	for i, got := range parsed.Events {
+		if !sameEvent(got, events[i]) || got.ExternalUID != events[i].ID+"@hmly" {
+			t.Errorf("event %d = %+v, want %+v", i, got, events[i])
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	for s, want := range map[string]time.Duration{
+		"PT30M":    30 * time.Minute,
+		"P1D":      24 * time.Hour,
+		"P1W":      7 * 24 * time.Hour,
+		"P1DT2H3S": 26*time.Hour + 3*time.Second,
+		"-PT15M":   -15 * time.Minute,
+	} {
+		if got, err := parseDuration(s); err != nil || got != want {
+			t.Errorf("parseDuration(%q) = %v, %v; want %v", s, got, err, want)
+		}
+	}
+	for _, s := range []string{"", "P", "PT", "30M", "P1H"} {
+		if _, err := parseDuration(s); err == nil {
+			t.Errorf("parseDuration(%q) succeeded", s)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package ical
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+// transition is a change of a zone's UTC offset.
+type transition struct {
+	at       time.Time
+	from, to int
+}
+
+// firstLocalYear returns the year of the earliest event written in
+// cal.Location, reporting whether there is one.
+func firstLocalYear(cal Calendar) (int, bool) {
+	var first time.Time
+	for _, ev := range cal.Events {
+		if zone(cal, ev) != nil && (first.IsZero() || ev.StartDate.Before(first)) {
+			first = ev.StartDate
+		}
+	}
+	if first.IsZero() {
+		return 0, false
+	}
+	return first.In(cal.Location).Year(), true
+}
+
+// timezone writes a VTIMEZONE for loc, describing the transitions it has in
+// year as yearly rules. A zone without transitions that year gets a single
+// STANDARD observance.
+func (e *encoder) timezone(loc *time.Location, year int) {
+	e.line("BEGIN", "VTIMEZONE")
+	e.line("TZID", loc.String())
+	transitions := transitionsIn(loc, year)
+	if len(transitions) == 0 {
+		start := time.Date(year, 1, 1, 0, 0, 0, 0, loc)
+		name, offset := start.Zone()
+		e.line("BEGIN", "STANDARD")
+		e.line("DTSTART", start.Format(localTimeLayout))
+		e.line("TZOFFSETFROM", formatOffset(offset))
+		e.line("TZOFFSETTO", formatOffset(offset))
+		e.line("TZNAME", escapeText(name))
+		e.line("END", "STANDARD")
+	}
+	for _, t := range transitions {
+		kind := "STANDARD"
+		if t.at.In(loc).IsDST() {
+			kind = "DAYLIGHT"
+		}
+		// An observance starts at the wall time before the change.
+		onset := t.at.In(time.FixedZone("", t.from))
+		last := onset.Day()+7 > time.Date(onset.Year(), onset.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		day := domain.WeekdayNum{N: (onset.Day()-1)/7 + 1, Weekday: onset.Weekday()}
+		if last {
+			day.N = -1
+		}
+		name, _ := t.at.In(loc).Zone()
+
+		e.line("BEGIN", kind)
+		e.line("DTSTART", onset.Format(localTimeLayout))
+		e.line("RRULE", fmt.Sprintf("FREQ=YEARLY;BYMONTH=%d;BYDAY=%s", onset.Month(), day))
+		e.line("TZOFFSETFROM", formatOffset(t.from))
+		e.line("TZOFFSETTO", formatOffset(t.to))
+		e.line("TZNAME", escapeText(name))
+		e.line("END", kind)
+	}
+	e.line("END", "VTIMEZONE")
+}
+
+// transitionsIn finds loc's offset changes in year by checking each day and
+// then narrowing to the second.
+func transitionsIn(loc *time.Location, year int) []transition {
+	var out []transition
+	end := time.Date(year+1, 1, 1, 0, 0, 0, 0, time.UTC)
+	for day := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC); day.Before(end); day = day.Add(24 * time.Hour) {
+		_, from := day.In(loc).Zone()
+		_, to := day.Add(24 * time.Hour).In(loc).Zone()
+		if from == to {
+			continue
+		}
+		lo, hi := day, day.Add(24*time.Hour)
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if _, off := mid.In(loc).Zone(); off == from {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		out = append(out, transition{at: hi, from: from, to: to})
+	}
+	return out
+}
+
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign, seconds = "-", -seconds
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds/60%60)
+}
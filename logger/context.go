@@ -0,0 +1,177 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+)
+
+type ctxKey int
+
+const (
+	requestIDKey ctxKey = iota
+	userIDKey
+	householdIDKey
+	traceIDKey
+	spanIDKey
+	attrsKey
+)
+
+// WithRequestID returns a copy of ctx carrying the request ID for log lines.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+// RequestID returns the request ID stored in ctx, or "".
+func RequestID(ctx context.Context) string {
+	return stringValue(ctx, requestIDKey)
+}
+
+func WithUserID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, userIDKey, id)
+}
+
+func UserID(ctx context.Context) string {
+	return stringValue(ctx, userIDKey)
+}
+
+func WithHouseholdID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, householdIDKey, id)
+}
+
+func HouseholdID(ctx context.Context) string {
+	return stringValue(ctx, householdIDKey)
+}
+
+// WithTrace returns a copy of ctx carrying the W3C trace and span IDs of the
+// current operation.
+func WithTrace(ctx context.Context, traceID, spanID string) context.Context {
+	ctx = context.WithValue(ctx, traceIDKey, traceID)
+	return context.WithValue(ctx, spanIDKey, spanID)
+}
+
+func TraceID(ctx context.Context) string {
+	return stringValue(ctx, traceIDKey)
+}
+
+func SpanID(ctx context.Context) string {
+	return stringValue(ctx, spanIDKey)
+}
+
+func stringValue(ctx context.Context, key ctxKey) string {
+	if ctx == nil {
+		return ""
+	}
+	v, _ := ctx.Value(key).(string)
+	return v
+}
+
+// WithContext returns a copy of ctx that adds attrs, given as slog key/value
+// pairs or slog.Attr values, to every log line written with it.
+func WithContext(ctx context.Context, attrs ...any) context.Context {
+	if len(attrs) == 0 {
+		return ctx
+	}
+	prev, _ := ctx.Value(attrsKey).([]slog.Attr)
+	merged := make([]slog.Attr, len(prev), len(prev)+len(attrs))
+	copy(merged, prev)
+	merged = append(merged, argsToAttrs(attrs)...)
+	return context.WithValue(ctx, attrsKey, merged)
+}
+
+// FromContext returns Default with the correlation IDs and attributes of ctx
+// already bound, for code that logs without passing ctx. Calls that do pass
+// ctx, like InfoContext, pick them up from any logger built by New.
+func FromContext(ctx context.Context) *slog.Logger {
+	attrs := contextAttrs(ctx)
+	if len(attrs) == 0 {
+		return Default()
+	}
+	return slog.New(bound{Default().Handler().WithAttrs(attrs)})
+}
+
+// contextAttrs collects the IDs and extra attributes stored in ctx.
+func contextAttrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	var attrs []slog.Attr
+	for _, f := range []struct {
+		name string
+		key  ctxKey
+	}{
+		{"request_id", requestIDKey},
+		{"user_id", userIDKey},
+		{"household_id", householdIDKey},
+		{"trace_id", traceIDKey},
+		{"span_id", spanIDKey},
+	} {
+		if v := stringValue(ctx, f.key); v != "" {
+			attrs = append(attrs, slog.String(f.name, v))
+		}
+	}
+	if extra, ok := ctx.Value(attrsKey).([]slog.Attr); ok {
+		attrs = append(attrs, extra...)
+	}
+	return attrs
+}
+
+func argsToAttrs(args []any) []slog.Attr {
+	var r slog.Record
+	r.Add(args...)
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
+
+// ContextHandler adds the correlation IDs and attributes carried by the
+// record's context to every record before passing it on.
+type ContextHandler struct {
+	slog.Handler
+}
+
+// NewContextHandler wraps h, returning it unchanged if it already adds
+// context attributes.
+func NewContextHandler(h slog.Handler) slog.Handler {
+	switch h.(type) {
+	case *ContextHandler, bound:
+		return h
+	}
+	return &ContextHandler{Handler: h}
+}
+
+func (h *ContextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs := contextAttrs(ctx); len(attrs) > 0 {
+		r = r.Clone()
+		r.AddAttrs(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *ContextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *ContextHandler) WithGroup(name string) slog.Handler {
+	return &ContextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// bound is the handler behind FromContext. Its context attributes are already
+// attached, so it skips the ContextHandler to avoid logging them twice.
+type bound struct {
+	slog.Handler
+}
+
+func (b bound) Handle(ctx context.Context, r slog.Record) error {
+	return b.Handler.Handle(context.Background(), r)
+}
+
+func (b bound) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return bound{b.Handler.WithAttrs(attrs)}
+}
+
+func (b bound) WithGroup(name string) slog.Handler {
+	return bound{b.Handler.WithGroup(name)}
+}
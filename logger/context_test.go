@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	ctx := context.Background()
+	if id := RequestID(ctx); id != "" {
+		t.Errorf("Expected empty request ID, got %q", id)
+	}
+
+	ctx = WithRequestID(ctx, "req-1")
+	if id := RequestID(ctx); id != "req-1" {
+		t.Errorf("Expected request ID req-1, got %q", id)
+	}
+}
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Invalid JSON log line %q: %v", line, err)
+		}
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func correlatedContext() context.Context {
+	ctx := WithRequestID(context.Background(), "req-1")
+	ctx = WithUserID(ctx, "user-1")
+	ctx = WithHouseholdID(ctx, "house-1")
+	ctx = WithTrace(ctx, "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7")
+	return WithContext(ctx, "component", "test", slog.Int("attempt", 2))
+}
+
+func TestContextHandler(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(Options{Format: FormatJSON, Output: &buf})
+
+	l.InfoContext(correlatedContext(), "with context")
+	l.Info("without context")
+
+	lines := decodeLines(t, &buf)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	want := map[string]any{
+		"request_id":   "req-1",
+		"user_id":      "user-1",
+		"household_id": "house-1",
+		"trace_id":     "4bf92f3577b34da6a3ce929d0e0e4736",
+		"span_id":      "00f067aa0ba902b7",
+		"component":    "test",
+		"attempt":      float64(2),
+	}
+	for key, value := range want {
+		if lines[0][key] != value {
+			t.Errorf("%s = %v, want %v", key, lines[0][key], value)
+		}
+	}
+	if _, ok := lines[1]["request_id"]; ok {
+		t.Error("Expected no request ID without a context")
+	}
+}
+
+func TestWithContext_DoesNotShareAttrs(t *testing.T) {
+	base := WithContext(context.Background(), "a", 1)
+	left := WithContext(base, "b", 2)
+	right := WithContext(base, "c", 3)
+
+	if n := len(contextAttrs(left)); n != 2 {
+		t.Errorf("Expected 2 attrs, got %d", n)
+	}
+	if attrs := contextAttrs(right); len(attrs) != 2 || attrs[1].Key != "c" {
+		t.Errorf("Unexpected attrs %v", attrs)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	prev := Default()
+	t.Cleanup(func() { SetDefault(prev) })
+
+	var buf bytes.Buffer
+	SetDefault(New(Options{Format: FormatJSON, Output: &buf}))
+	ctx := correlatedContext()
+
+	FromContext(ctx).Info("plain")
+	FromContext(ctx).InfoContext(ctx, "with context")
+	if FromContext(context.Background()) != Default() {
+		t.Error("Expected Default for a context without attributes")
+	}
+
+	for _, line := range decodeLines(t, &buf) {
+		if line["request_id"] != "req-1" || line["component"] != "test" {
+			t.Errorf("Expected context attributes, got %v", line)
+		}
+	}
+	if n := strings.Count(buf.String(), `"request_id"`); n != 2 {
+		t.Errorf("Expected request_id once per line, got %d occurrences", n)
+	}
+}
+
+func TestNewContextHandler_Idempotent(t *testing.T) {
+	h := NewContextHandler(slog.NewTextHandler(&bytes.Buffer{}, nil))
+	if NewContextHandler(h) != h {
+		t.Error("Expected an existing context handler to be reused")
+	}
+}
@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type headerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *headerStream) Context() context.Context       { return s.ctx }
+func (s *headerStream) SetHeader(md metadata.MD) error { s.header = md; return nil }
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	md := metadata.Pairs(RequestIDHeader, "req-1", TraceParentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	var got context.Context
+	_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req any) (any, error) {
+		got = ctx
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if RequestID(got) != "req-1" || TraceID(got) != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected propagated IDs, got %q %q", RequestID(got), TraceID(got))
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ss := &headerStream{ctx: context.Background()}
+	var got context.Context
+	err := StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		got = stream.Context()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	id := RequestID(got)
+	if id == "" {
+		t.Fatal("Expected a generated request ID")
+	}
+	if v := ss.header.Get(RequestIDHeader); len(v) != 1 || v[0] != id {
+		t.Errorf("Expected request ID header %q, got %v", id, v)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	ctx := WithTrace(WithRequestID(context.Background(), "req-1"), "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7")
+
+	var md metadata.MD
+	err := UnaryClientInterceptor()(ctx, "/svc/Method", nil, nil, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if v := md.Get(RequestIDHeader); len(v) != 1 || v[0] != "req-1" {
+		t.Errorf("Unexpected request ID metadata %v", v)
+	}
+	if v := md.Get(TraceParentHeader); len(v) != 1 || v[0] != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("Unexpected traceparent metadata %v", v)
+	}
+
+	// Metadata set explicitly by the caller wins.
+	ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, "explicit")
+	_ = UnaryClientInterceptor()(ctx, "/svc/Method", nil, nil, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	})
+	if v := md.Get(RequestIDHeader); len(v) != 1 || v[0] != "explicit" {
+		t.Errorf("Unexpected request ID metadata %v", v)
+	}
+}
@@ -0,0 +1,43 @@
+package logger
+
+import "net/http"
+
+// Middleware reads X-Request-Id and traceparent from the request, generating
+// them when missing, stores them in the request context and sets X-Request-Id
+// on the response. Requests proxied on through grpc-gateway keep the IDs when
+// the gRPC client uses UnaryClientInterceptor.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := incoming(r.Context(), r.Header.Get(RequestIDHeader), r.Header.Get(TraceParentHeader))
+		w.Header().Set(RequestIDHeader, RequestID(ctx))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Transport forwards the request ID and trace context of each request's
+// context to outgoing HTTP calls. A nil base uses http.DefaultTransport.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return roundTripper{base}
+}
+
+type roundTripper struct {
+	base http.RoundTripper
+}
+
+func (t roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	id, tp := RequestID(r.Context()), TraceParent(r.Context())
+	if id == "" && tp == "" {
+		return t.base.RoundTrip(r)
+	}
+	r = r.Clone(r.Context())
+	if id != "" && r.Header.Get(RequestIDHeader) == "" {
+		r.Header.Set(RequestIDHeader, id)
+	}
+	if tp != "" && r.Header.Get(TraceParentHeader) == "" {
+		r.Header.Set(TraceParentHeader, tp)
+	}
+	return t.base.RoundTrip(r)
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	var got context.Context
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Context()
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Request-Id", "req-1")
+	req.Header.Set("Traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if RequestID(got) != "req-1" || TraceID(got) != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected propagated IDs, got %q %q", RequestID(got), TraceID(got))
+	}
+	if h := rec.Header().Get("X-Request-Id"); h != "req-1" {
+		t.Errorf("X-Request-Id = %q, want req-1", h)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if id := RequestID(got); id == "" || rec.Header().Get("X-Request-Id") != id {
+		t.Errorf("Expected a generated request ID echoed in the response, got %q", id)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	ctx := WithTrace(WithRequestID(context.Background(), "req-1"), "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	resp, err := (&http.Client{Transport: Transport(nil)}).Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if header.Get("X-Request-Id") != "req-1" {
+		t.Errorf("X-Request-Id = %q, want req-1", header.Get("X-Request-Id"))
+	}
+	if header.Get("Traceparent") != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("Unexpected traceparent %q", header.Get("Traceparent"))
+	}
+	if req.Header.Get("X-Request-Id") != "" {
+		t.Error("Expected the caller's request to be left untouched")
+	}
+}
@@ -1,10 +1,160 @@
 package logger
 
 import (
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strings"
+	"sync/atomic"
 )
 
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Options configures New. Empty Format and Level fall back to the LOG_FORMAT
+// and LOG_LEVEL environment variables, then to text at info.
+type Options struct {
+	Format    string
+	Level     string
+	Service   string
+	Env       string
+	Version   string
+	AddSource bool
+	Output    io.Writer
+	// LevelVar, when set, lets the caller change the level at runtime.
+	LevelVar *slog.LevelVar
+	// RedactRules replaces DefaultRedactRules. Redaction is on unless
+	// DisableRedaction is set.
+	RedactRules      []RedactRule
+	DisableRedaction bool
+	// Sampling replaces DefaultSampling. Sampling is on unless
+	// DisableSampling is set.
+	Sampling        map[slog.Level]SamplingRule
+	DisableSampling bool
+}
+
+var (
+	level         = new(slog.LevelVar)
+	defaultLogger atomic.Pointer[slog.Logger]
+)
+
+func init() {
+	defaultLogger.Store(New(Options{LevelVar: level}))
+}
+
+// New builds a logger from opts. Service, env and version are attached to
+// every record when set, along with the correlation IDs of the context passed
+// to the *Context logging methods. Sensitive values are masked and repeated
+// warnings and errors sampled unless disabled.
+func New(opts Options) *slog.Logger {
+	if opts.Format == "" {
+		opts.Format = os.Getenv("LOG_FORMAT")
+	}
+	if opts.Level == "" {
+		opts.Level = os.Getenv("LOG_LEVEL")
+	}
+	if opts.Output == nil {
+		opts.Output = os.Stderr
+	}
+	levelVar := opts.LevelVar
+	if levelVar == nil {
+		levelVar = new(slog.LevelVar)
+	}
+	if lvl, err := ParseLevel(opts.Level); err == nil {
+		levelVar.Set(lvl)
+	}
+
+	handlerOpts := &slog.HandlerOptions{
+		AddSource: opts.AddSource,
+		Level:     levelVar,
+	}
+	var handler slog.Handler
+	if strings.EqualFold(opts.Format, FormatJSON) {
+		handler = slog.NewJSONHandler(opts.Output, handlerOpts)
+	} else {
+		handler = slog.NewTextHandler(opts.Output, handlerOpts)
+	}
+
+	if !opts.DisableRedaction {
+		handler = NewRedactHandler(handler, opts.RedactRules...)
+	}
+	if !opts.DisableSampling {
+		sampling := opts.Sampling
+		if sampling == nil {
+			sampling = DefaultSampling()
+		}
+		handler = NewSamplingHandler(handler, sampling)
+	}
+	handler = NewContextHandler(handler)
+
+	var attrs []slog.Attr
+	if opts.Service != "" {
+		attrs = append(attrs, slog.String("service", opts.Service))
+	}
+	if opts.Env != "" {
+		attrs = append(attrs, slog.String("env", opts.Env))
+	}
+	if opts.Version != "" {
+		attrs = append(attrs, slog.String("version", opts.Version))
+	}
+	if len(attrs) > 0 {
+		handler = handler.WithAttrs(attrs)
+	}
+	return slog.New(handler)
+}
+
+// Init replaces the process-wide logger returned by Default and installs it
+// as the slog default. Its level can then be changed with SetLevel.
+func Init(opts Options) *slog.Logger {
+	opts.LevelVar = level
+	l := New(opts)
+	SetDefault(l)
+	return l
+}
+
+// Default returns the process-wide logger. Packages should call it at log time
+// rather than caching the result, so that Init takes effect everywhere.
+func Default() *slog.Logger {
+	return defaultLogger.Load()
+}
+
+func SetDefault(l *slog.Logger) {
+	defaultLogger.Store(l)
+	slog.SetDefault(l)
+}
+
+// SetLevel changes the level of the logger installed by Init.
+func SetLevel(lvl slog.Level) {
+	level.Set(lvl)
+}
+
+func Level() slog.Level {
+	return level.Level()
+}
+
+// ParseLevel accepts debug, info, warn/warning and error in any case.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("logger: unknown level %q", s)
+	}
+}
+
+// NewLogger returns a text logger on stderr at info level.
+//
+// Deprecated: use Default for the process-wide logger or New for a
+// configured one.
 func NewLogger() *slog.Logger {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 	return logger
@@ -1,7 +1,10 @@
 package logger
 
 import (
+	"bytes"
+	"encoding/json"
 	"log/slog"
+	"strings"
 	"testing"
 )
 
@@ -17,3 +20,108 @@ func TestNewLogger(t *testing.T) {
 	}
 	logger.Info("Logger created successfully")
 }
+
+func TestNew_JSONWithBaseAttributes(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(Options{
+		Format:    FormatJSON,
+		Level:     "debug",
+		Service:   "meal",
+		Env:       "prod",
+		Version:   "1.2.3",
+		AddSource: true,
+		Output:    &buf,
+	})
+	l.Debug("hello", "n", 1)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected JSON output, got %q: %v", buf.String(), err)
+	}
+	for key, want := range map[string]any{"msg": "hello", "level": "DEBUG", "service": "meal", "env": "prod", "version": "1.2.3"} {
+		if entry[key] != want {
+			t.Errorf("%s = %v, want %v", key, entry[key], want)
+		}
+	}
+	if _, ok := entry["source"]; !ok {
+		t.Error("Expected source location to be logged")
+	}
+}
+
+func TestNew_TextDefaultsAndEnv(t *testing.T) {
+	var buf bytes.Buffer
+	New(Options{Output: &buf}).Debug("hidden")
+	if buf.Len() != 0 {
+		t.Errorf("Expected debug to be filtered at the default level, got %q", buf.String())
+	}
+
+	t.Setenv("LOG_LEVEL", "warn")
+	t.Setenv("LOG_FORMAT", "text")
+	l := New(Options{Output: &buf})
+	if h, ok := l.Handler().(*ContextHandler); !ok {
+		t.Error("Expected a context handler")
+	} else if sh, ok := h.Handler.(*SamplingHandler); !ok {
+		t.Error("Expected a sampling handler")
+	} else if r, ok := sh.handler.(*RedactHandler); !ok {
+		t.Error("Expected a redact handler")
+	} else if _, ok := r.handler.(*slog.TextHandler); !ok {
+		t.Error("Expected a text handler")
+	}
+	l.Info("hidden")
+	l.Warn("shown")
+	if out := buf.String(); strings.Contains(out, "hidden") || !strings.Contains(out, "msg=shown") {
+		t.Errorf("Unexpected output %q", out)
+	}
+}
+
+func TestNew_LevelVar(t *testing.T) {
+	var buf bytes.Buffer
+	levelVar := new(slog.LevelVar)
+	l := New(Options{Level: "error", Output: &buf, LevelVar: levelVar})
+	l.Info("before")
+	levelVar.Set(slog.LevelInfo)
+	l.Info("after")
+	if out := buf.String(); strings.Contains(out, "before") || !strings.Contains(out, "after") {
+		t.Errorf("Unexpected output %q", out)
+	}
+}
+
+func TestInitAndSetLevel(t *testing.T) {
+	prev, prevLevel := Default(), Level()
+	t.Cleanup(func() {
+		SetDefault(prev)
+		SetLevel(prevLevel)
+	})
+
+	var buf bytes.Buffer
+	l := Init(Options{Level: "info", Output: &buf, Service: "gateway"})
+	if Default() != l || slog.Default() != l {
+		t.Fatal("Expected Init to install the process-wide logger")
+	}
+
+	Default().Debug("hidden")
+	SetLevel(slog.LevelDebug)
+	Default().Debug("shown")
+	if out := buf.String(); strings.Contains(out, "hidden") || !strings.Contains(out, "service=gateway") {
+		t.Errorf("Unexpected output %q", out)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := map[string]slog.Level{
+		"debug":   slog.LevelDebug,
+		"INFO":    slog.LevelInfo,
+		"":        slog.LevelInfo,
+		"warning": slog.LevelWarn,
+		" error ": slog.LevelError,
+	}
+	for in, want := range tests {
+		got, err := ParseLevel(in)
+		if err != nil || got != want {
+			t.Errorf("ParseLevel(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+}
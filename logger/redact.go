@@ -0,0 +1,154 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces masked values.
+const Redacted = "[REDACTED]"
+
+var (
+	EmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	JWTPattern   = regexp.MustCompile(`eyJ[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]+\.[A-Za-z0-9_\-]*`)
+	// DSNPasswordPattern matches the password of a URL-style connection
+	// string; DSNKeywordPasswordPattern the password=... of a keyword/value one.
+	DSNPasswordPattern        = regexp.MustCompile(`(\b[a-zA-Z][a-zA-Z0-9+.\-]*://[^:/@\s]*:)[^@\s]+@`)
+	DSNKeywordPasswordPattern = regexp.MustCompile(`(?i)(\bpassword=)('[^']*'|\S+)`)
+)
+
+// RedactRule masks an attribute entirely when its key matches Key, or masks
+// the substrings of string values that match Pattern.
+type RedactRule struct {
+	// Key matches case-insensitively, either the whole key or its last
+	// underscore-separated part, so "api_key" also covers "clerk_api_key".
+	Key     string
+	Pattern *regexp.Regexp
+	// Replacement is used for Pattern matches and may refer to submatches.
+	// It defaults to Redacted.
+	Replacement string
+}
+
+// DefaultRedactRules masks credentials by key and emails, JWTs and
+// connection string passwords wherever they appear.
+func DefaultRedactRules() []RedactRule {
+	rules := []RedactRule{
+		{Pattern: DSNPasswordPattern, Replacement: "${1}" + Redacted + "@"},
+		{Pattern: DSNKeywordPasswordPattern, Replacement: "${1}" + Redacted},
+		{Pattern: JWTPattern},
+		{Pattern: EmailPattern},
+	}
+	for _, key := range []string{"password", "token", "secret", "authorization", "cookie", "api_key", "apikey"} {
+		rules = append(rules, RedactRule{Key: key})
+	}
+	return rules
+}
+
+// RedactString applies the pattern rules to s.
+func RedactString(s string, rules ...RedactRule) string {
+	for _, rule := range rules {
+		if rule.Pattern == nil {
+			continue
+		}
+		replacement := rule.Replacement
+		if replacement == "" {
+			replacement = Redacted
+		}
+		s = rule.Pattern.ReplaceAllString(s, replacement)
+	}
+	return s
+}
+
+// MaskEmail keeps the first letter and the domain of an address, so
+// jane@example.com becomes j***@example.com.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		if email == "" {
+			return ""
+		}
+		return Redacted
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+// RedactHandler masks sensitive attributes and message contents before
+// passing records on. LogValuer values are resolved first, so the rules
+// also apply to what they return.
+type RedactHandler struct {
+	handler slog.Handler
+	rules   []RedactRule
+}
+
+// NewRedactHandler wraps h with rules, or with DefaultRedactRules when none
+// are given.
+func NewRedactHandler(h slog.Handler, rules ...RedactRule) *RedactHandler {
+	if len(rules) == 0 {
+		rules = DefaultRedactRules()
+	}
+	return &RedactHandler{handler: h, rules: rules}
+}
+
+func (h *RedactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *RedactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, RedactString(r.Message, h.rules...), r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redact(a))
+		return true
+	})
+	return h.handler.Handle(ctx, out)
+}
+
+func (h *RedactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redact(a)
+	}
+	return &RedactHandler{handler: h.handler.WithAttrs(redacted), rules: h.rules}
+}
+
+func (h *RedactHandler) WithGroup(name string) slog.Handler {
+	return &RedactHandler{handler: h.handler.WithGroup(name), rules: h.rules}
+}
+
+func (h *RedactHandler) redact(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if h.matchesKey(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	switch a.Value.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(RedactString(a.Value.String(), h.rules...))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			redacted[i] = h.redact(ga)
+		}
+		a.Value = slog.GroupValue(redacted...)
+	case slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			a.Value = slog.StringValue(RedactString(err.Error(), h.rules...))
+		}
+	}
+	return a
+}
+
+func (h *RedactHandler) matchesKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, rule := range h.rules {
+		if rule.Key == "" {
+			continue
+		}
+		ruleKey := strings.ToLower(rule.Key)
+		if key == ruleKey || strings.HasSuffix(key, "_"+ruleKey) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// maxSampleKeys bounds how many distinct messages the sampler tracks before
+// it sweeps finished windows.
+const maxSampleKeys = 10000
+
+// SamplingRule limits how often a single message is logged: the first First
+// records in each Interval pass, then every Thereafter-th one. A Thereafter
+// of zero drops the rest of the interval.
+type SamplingRule struct {
+	First      int
+	Thereafter int
+	Interval   time.Duration
+}
+
+// DefaultSampling samples warnings and errors, which are what floods the logs
+// when Consul or Postgres go down. Info and debug are left alone.
+func DefaultSampling() map[slog.Level]SamplingRule {
+	rule := SamplingRule{First: 10, Thereafter: 100, Interval: time.Second}
+	return map[slog.Level]SamplingRule{
+		slog.LevelWarn:  rule,
+		slog.LevelError: rule,
+	}
+}
+
+type sampleKey struct {
+	level   slog.Level
+	message string
+}
+
+type sampleWindow struct {
+	start   time.Time
+	count   int
+	dropped int
+	handler slog.Handler
+	timer   *time.Timer
+}
+
+type sampler struct {
+	mu      sync.Mutex
+	rules   map[slog.Level]SamplingRule
+	windows map[sampleKey]*sampleWindow
+	now     func() time.Time
+}
+
+// SamplingHandler drops repeats of the same level and message according to
+// per-level rules. When a window that dropped records ends, it logs the
+// message once more as "<message> (repeated N times)" with a repeated
+// attribute holding the number dropped.
+type SamplingHandler struct {
+	handler slog.Handler
+	s       *sampler
+}
+
+// NewSamplingHandler wraps h. Levels without a rule are never sampled.
+func NewSamplingHandler(h slog.Handler, rules map[slog.Level]SamplingRule) *SamplingHandler {
+	return &SamplingHandler{
+		handler: h,
+		s: &sampler{
+			rules:   rules,
+			windows: make(map[sampleKey]*sampleWindow),
+			now:     time.Now,
+		},
+	}
+}
+
+func (h *SamplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.handler.Enabled(ctx, level)
+}
+
+func (h *SamplingHandler) Handle(ctx context.Context, r slog.Record) error {
+	rule, ok := h.s.rules[r.Level]
+	if !ok || rule.Interval <= 0 {
+		return h.handler.Handle(ctx, r)
+	}
+	key := sampleKey{r.Level, r.Message}
+
+	h.s.mu.Lock()
+	now := h.s.now()
+	w := h.s.windows[key]
+	var ended *sampleWindow
+	if w == nil || now.Sub(w.start) >= rule.Interval {
+		if w != nil && w.timer != nil && w.timer.Stop() {
+			ended = w
+		}
+		if w == nil && len(h.s.windows) >= maxSampleKeys {
+			h.s.sweep(now)
+		}
+		w = &sampleWindow{start: now}
+		h.s.windows[key] = w
+	}
+	w.count++
+	keep := w.count <= rule.First ||
+		(rule.Thereafter > 0 && (w.count-rule.First)%rule.Thereafter == 0)
+	if !keep {
+		w.dropped++
+		w.handler = h.handler
+		if w.timer == nil {
+			current := w
+			w.timer = time.AfterFunc(w.start.Add(rule.Interval).Sub(now), func() {
+				h.s.summarize(key, current)
+			})
+		}
+	}
+	h.s.mu.Unlock()
+
+	if ended != nil {
+		h.s.summarize(key, ended)
+	}
+	if !keep {
+		return nil
+	}
+	return h.handler.Handle(ctx, r)
+}
+
+func (h *SamplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SamplingHandler{handler: h.handler.WithAttrs(attrs), s: h.s}
+}
+
+func (h *SamplingHandler) WithGroup(name string) slog.Handler {
+	return &SamplingHandler{handler: h.handler.WithGroup(name), s: h.s}
+}
+
+// Flush logs the summaries of every window that has dropped records without
+// waiting for it to end, e.g. before the process exits.
+func (h *SamplingHandler) Flush() {
+	h.s.mu.Lock()
+	pending := make(map[sampleKey]*sampleWindow)
+	for key, w := range h.s.windows {
+		if w.timer != nil && w.timer.Stop() {
+			pending[key] = w
+		}
+	}
+	h.s.mu.Unlock()
+
+	for key, w := range pending {
+		h.s.summarize(key, w)
+	}
+}
+
+func (s *sampler) summarize(key sampleKey, w *sampleWindow) {
+	s.mu.Lock()
+	dropped, handler := w.dropped, w.handler
+	w.dropped, w.timer = 0, nil
+	now := s.now()
+	s.mu.Unlock()
+
+	if dropped == 0 {
+		return
+	}
+	r := slog.NewRecord(now, key.level, fmt.Sprintf("%s (repeated %d times)", key.message, dropped), 0)
+	r.AddAttrs(slog.Int("repeated", dropped))
+	_ = handler.Handle(context.Background(), r)
+}
+
+// sweep forgets windows that have ended and have nothing left to summarize.
+// Callers hold s.mu.
+func (s *sampler) sweep(now time.Time) {
+	for key, w := range s.windows {
+		rule := s.rules[key.level]
+		if w.timer == nil && now.Sub(w.start) >= rule.Interval {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// syncBuffer is written to from the sampler's summary timers.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestSampler(buf *syncBuffer, rules map[slog.Level]SamplingRule) (*SamplingHandler, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	h := NewSamplingHandler(slog.NewTextHandler(buf, nil), rules)
+	h.s.now = clock.Now
+	return h, clock
+}
+
+func TestSamplingHandler_FirstThenEveryM(t *testing.T) {
+	var buf syncBuffer
+	h, _ := newTestSampler(&buf, map[slog.Level]SamplingRule{
+		slog.LevelError: {First: 2, Thereafter: 3, Interval: time.Hour},
+	})
+	l := slog.New(h)
+
+	for i := 0; i < 10; i++ {
+		l.Error("db down", "i", i)
+		l.Info("request")
+	}
+
+	out := buf.String()
+	// Records 1, 2, 5 and 8 pass: the first two, then every third.
+	for _, i := range []string{"i=0", "i=1", "i=4", "i=7"} {
+		if !strings.Contains(out, i) {
+			t.Errorf("Expected %s to be logged in %s", i, out)
+		}
+	}
+	if n := strings.Count(out, "db down"); n != 4 {
+		t.Errorf("Expected 4 sampled errors, got %d", n)
+	}
+	if n := strings.Count(out, "msg=request"); n != 10 {
+		t.Errorf("Expected unsampled info to pass, got %d", n)
+	}
+
+	h.Flush()
+	if !strings.Contains(buf.String(), `msg="db down (repeated 6 times)" repeated=6`) {
+		t.Errorf("Expected a summary after Flush, got %s", buf.String())
+	}
+}
+
+func TestSamplingHandler_SummaryOnNextWindow(t *testing.T) {
+	var buf syncBuffer
+	h, clock := newTestSampler(&buf, map[slog.Level]SamplingRule{
+		slog.LevelWarn: {First: 1, Interval: time.Hour},
+	})
+	l := slog.New(h).With("component", "discovery")
+
+	for i := 0; i < 4; i++ {
+		l.Warn("consul unreachable")
+	}
+	clock.Advance(time.Hour)
+	l.Warn("consul unreachable")
+
+	out := buf.String()
+	if !strings.Contains(out, `msg="consul unreachable (repeated 3 times)" component=discovery repeated=3`) {
+		t.Errorf("Expected a summary when the window rolled over, got %s", out)
+	}
+	if n := strings.Count(out, "msg=\"consul unreachable\""); n != 2 {
+		t.Errorf("Expected the first record of each window, got %d in %s", n, out)
+	}
+}
+
+func TestSamplingHandler_SummaryTimer(t *testing.T) {
+	var buf syncBuffer
+	h := NewSamplingHandler(slog.NewTextHandler(&buf, nil), map[slog.Level]SamplingRule{
+		slog.LevelError: {First: 1, Interval: 20 * time.Millisecond},
+	})
+	l := slog.New(h)
+	l.Error("boom")
+	l.Error("boom")
+
+	deadline := time.Now().Add(time.Second)
+	for !strings.Contains(buf.String(), "repeated 1 times") {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected a summary once the window ended, got %s", buf.String())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSamplingHandler_SweepsOldWindows(t *testing.T) {
+	var buf syncBuffer
+	h, clock := newTestSampler(&buf, map[slog.Level]SamplingRule{
+		slog.LevelError: {First: 1, Interval: time.Second},
+	})
+	for i := 0; i < maxSampleKeys; i++ {
+		h.s.windows[sampleKey{slog.LevelError, strconv.Itoa(i)}] = &sampleWindow{start: clock.Now()}
+	}
+	clock.Advance(time.Second)
+	_ = h.Handle(context.Background(), slog.NewRecord(clock.Now(), slog.LevelError, "new", 0))
+	if n := len(h.s.windows); n != 1 {
+		t.Errorf("Expected ended windows to be swept, %d left", n)
+	}
+}
+
+func TestNew_SamplingOptions(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(Options{Output: &buf, Sampling: map[slog.Level]SamplingRule{
+		slog.LevelError: {First: 1, Interval: time.Hour},
+	}})
+	l.Error("once")
+	l.Error("once")
+	if n := strings.Count(buf.String(), "msg=once"); n != 1 {
+		t.Errorf("Expected sampling, got %s", buf.String())
+	}
+
+	buf.Reset()
+	l = New(Options{Output: &buf, DisableSampling: true})
+	for i := 0; i < 20; i++ {
+		l.Error("many")
+	}
+	if n := strings.Count(buf.String(), "msg=many"); n != 20 {
+		t.Errorf("Expected no sampling, got %d lines", n)
+	}
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	RequestIDHeader   = "x-request-id"
+	TraceParentHeader = "traceparent"
+)
+
+// NewRequestID returns a random 128-bit ID in hex.
+func NewRequestID() string {
+	return randomHex(16)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// parseTraceParent extracts the trace and parent span IDs from a W3C
+// traceparent value such as 00-<32 hex>-<16 hex>-01.
+func parseTraceParent(v string) (traceID, spanID string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(v), "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return "", "", false
+	}
+	if !isHex(parts[1]) || !isHex(parts[2]) || strings.Trim(parts[1], "0") == "" || strings.Trim(parts[2], "0") == "" {
+		return "", "", false
+	}
+	return strings.ToLower(parts[1]), strings.ToLower(parts[2]), true
+}
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// TraceParent formats the trace context of ctx as a traceparent value, or ""
+// when ctx carries none.
+func TraceParent(ctx context.Context) string {
+	traceID, spanID := TraceID(ctx), SpanID(ctx)
+	if traceID == "" || spanID == "" {
+		return ""
+	}
+	return fmt.Sprintf("00-%s-%s-01", traceID, spanID)
+}
+
+// incoming returns ctx carrying the request ID (generated when id is empty)
+// and a new span, continuing the trace in traceparent if it is valid.
+func incoming(ctx context.Context, id, traceparent string) context.Context {
+	if id == "" {
+		id = NewRequestID()
+	}
+	ctx = WithRequestID(ctx, id)
+	traceID, _, ok := parseTraceParent(traceparent)
+	if !ok {
+		traceID = randomHex(16)
+	}
+	return WithTrace(ctx, traceID, randomHex(8))
+}
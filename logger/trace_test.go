@@ -0,0 +1,50 @@
+package logger
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseTraceParent(t *testing.T) {
+	traceID, spanID, ok := parseTraceParent("00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01")
+	if !ok || traceID != "4bf92f3577b34da6a3ce929d0e0e4736" || spanID != "00f067aa0ba902b7" {
+		t.Errorf("Unexpected result %q %q %v", traceID, spanID, ok)
+	}
+
+	for _, v := range []string{
+		"",
+		"garbage",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473z-00f067aa0ba902b7-01",
+	} {
+		if _, _, ok := parseTraceParent(v); ok {
+			t.Errorf("Expected %q to be rejected", v)
+		}
+	}
+}
+
+func TestTraceParent(t *testing.T) {
+	if tp := TraceParent(context.Background()); tp != "" {
+		t.Errorf("Expected no traceparent, got %q", tp)
+	}
+	ctx := WithTrace(context.Background(), "4bf92f3577b34da6a3ce929d0e0e4736", "00f067aa0ba902b7")
+	if tp := TraceParent(ctx); tp != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("Unexpected traceparent %q", tp)
+	}
+}
+
+func TestIncoming(t *testing.T) {
+	ctx := incoming(context.Background(), "", "")
+	if len(RequestID(ctx)) != 32 || len(TraceID(ctx)) != 32 || len(SpanID(ctx)) != 16 {
+		t.Errorf("Expected generated IDs, got %q %q %q", RequestID(ctx), TraceID(ctx), SpanID(ctx))
+	}
+
+	ctx = incoming(context.Background(), "req-1", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if RequestID(ctx) != "req-1" || TraceID(ctx) != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected propagated IDs, got %q %q", RequestID(ctx), TraceID(ctx))
+	}
+	if SpanID(ctx) == "00f067aa0ba902b7" {
+		t.Error("Expected a new span for the server side")
+	}
+}
@@ -0,0 +1,304 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/ical"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/utils"
+)
+
+// EventToProto converts e with its times in loc; nil is UTC. All-day dates are
+// written as YYYY-MM-DD.
+func EventToProto(e domain.Event, loc *time.Location) *proto.EventResponse {
+	resp := &proto.EventResponse{
+		Id:          e.ID,
+		Name:        e.Name,
+		EntityId:    e.EntityID,
+		EntityType:  e.EntityType,
+		HouseholdId: e.HouseholdID,
+		StartDate:   formatEventTime(e.StartDate, e.AllDay, loc),
+		EndDate:     formatEventTime(e.EndDate, e.AllDay, loc),
+		AssignedTo:  e.AssignedTo,
+		AllDay:      e.AllDay,
+		Rrule:       e.RRule,
+		SeriesId:    e.SeriesID,
+		CreatedAt:   formatTime(e.CreatedAt),
+		UpdatedAt:   formatTime(e.UpdatedAt),
+	}
+	for _, t := range e.ExDates {
+		resp.Exdates = append(resp.Exdates, formatEventTime(t, e.AllDay, loc))
+	}
+	if e.RecurrenceID != nil {
+		resp.RecurrenceId = formatEventTime(*e.RecurrenceID, e.AllDay, loc)
+	}
+	return resp
+}
+
+func EventsToProto(events []domain.Event, loc *time.Location) *proto.EventsResponse {
+	resp := &proto.EventsResponse{Events: make([]*proto.EventResponse, len(events))}
+	for i, e := range events {
+		resp.Events[i] = EventToProto(e, loc)
+	}
+	return resp
+}
+
+// OccurrencesToProto converts occurrences, each carrying the ID of the event
+// it came from, its series and its original start.
+func OccurrencesToProto(occurrences []domain.Occurrence, loc *time.Location) *proto.EventsResponse {
+	resp := &proto.EventsResponse{Events: make([]*proto.EventResponse, len(occurrences))}
+	for i, o := range occurrences {
+		e := EventToProto(o.Event, loc)
+		if e.SeriesId == "" && o.Event.IsRecurring() {
+			e.SeriesId = o.Event.ID
+		}
+		if !o.RecurrenceID.IsZero() {
+			e.RecurrenceId = formatEventTime(o.RecurrenceID, o.Event.AllDay, loc)
+		}
+		resp.Events[i] = e
+	}
+	return resp
+}
+
+// EventFromProto is the inverse of EventToProto.
+func EventFromProto(resp *proto.EventResponse) (domain.Event, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	if err != nil {
+		return domain.Event{}, err
+	}
+	e := domain.Event{
+		BaseModel:   base,
+		Name:        resp.GetName(),
+		EntityID:    resp.GetEntityId(),
+		EntityType:  resp.GetEntityType(),
+		HouseholdID: resp.GetHouseholdId(),
+		AssignedTo:  resp.GetAssignedTo(),
+		AllDay:      resp.GetAllDay(),
+		RRule:       resp.GetRrule(),
+		SeriesID:    resp.GetSeriesId(),
+	}
+	err = setEventTimes(&e, eventTimes{
+		start: resp.GetStartDate(), end: resp.GetEndDate(),
+		exDates: resp.GetExdates(), recurrenceID: resp.GetRecurrenceId(),
+	}, nil)
+	return e, err
+}
+
+// EventFromCreate converts a create request, reading times without an offset
+// in loc; nil is UTC. Without an end date the event lasts a day if it is
+// all-day and no time otherwise.
+func EventFromCreate(req *proto.CreateEventRequest, loc *time.Location) (domain.Event, error) {
+	e := domain.Event{
+		Name:        req.GetName(),
+		EntityID:    req.GetEntityId(),
+		EntityType:  req.GetEntityType(),
+		HouseholdID: req.GetHouseholdId(),
+		AssignedTo:  req.GetAssignedTo(),
+		AllDay:      req.GetAllDay(),
+		RRule:       req.GetRrule(),
+	}
+	err := setEventTimes(&e, eventTimes{start: req.GetStartDate(), end: req.GetEndDate(), exDates: req.GetExdates()}, loc)
+	if err != nil {
+		return e, err
+	}
+	return e, e.Validate()
+}
+
+// EventFromUpdate converts an update request like EventFromCreate, with the
+// event's ID and the recurrence ID the request names. The scope is left to
+// the caller, which decides how the update applies to a series.
+func EventFromUpdate(req *proto.UpdateEventRequest, loc *time.Location) (domain.Event, error) {
+	e := domain.Event{
+		BaseModel:   domain.BaseModel{ID: req.GetId()},
+		Name:        req.GetName(),
+		EntityID:    req.GetEntityId(),
+		EntityType:  req.GetEntityType(),
+		HouseholdID: req.GetHouseholdId(),
+		AssignedTo:  req.GetAssignedTo(),
+		AllDay:      req.GetAllDay(),
+		RRule:       req.GetRrule(),
+	}
+	err := setEventTimes(&e, eventTimes{
+		start: req.GetStartDate(), end: req.GetEndDate(),
+		exDates: req.GetExdates(), recurrenceID: req.GetRecurrenceId(),
+	}, loc)
+	if err != nil {
+		return e, err
+	}
+	return e, e.Validate()
+}
+
+// EventFilterFromGetEvents converts a list request. Its window is read in its
+// time zone, which is also the zone all-day events are matched in.
+func EventFilterFromGetEvents(req *proto.GetEventsRequest) (repository.EventFilter, error) {
+	return eventFilter(eventQuery{
+		entityType: req.GetEntityType(), entityID: req.GetEntityId(), householdID: req.GetHouseholdId(),
+		assignedTo: req.GetAssignedTo(), start: req.GetStart(), end: req.GetEnd(), timeZone: req.GetTimeZone(),
+		offset: req.GetOffset(), limit: req.GetLimit(),
+	})
+}
+
+// EventFilterFromListOccurrences converts an occurrences request, whose
+// window must be bounded.
+func EventFilterFromListOccurrences(req *proto.ListOccurrencesRequest) (repository.EventFilter, error) {
+	f, err := eventFilter(eventQuery{
+		entityType: req.GetEntityType(), entityID: req.GetEntityId(), householdID: req.GetHouseholdId(),
+		assignedTo: req.GetAssignedTo(), start: req.GetStart(), end: req.GetEnd(), timeZone: req.GetTimeZone(),
+		offset: req.GetOffset(), limit: req.GetLimit(),
+	})
+	if err == nil && (f.From.IsZero() || f.To.IsZero()) {
+		err = repository.ErrUnboundedWindow
+	}
+	return f, err
+}
+
+// ImportOptionsFromRequest converts an import request; the ICS data is
+// req.GetIcs().
+func ImportOptionsFromRequest(req *proto.ImportEventsRequest) (ical.ImportOptions, error) {
+	if req.GetHouseholdId() == "" {
+		return ical.ImportOptions{}, fmt.Errorf("%w: household_id is required", ErrInvalidArgument)
+	}
+	loc, err := utils.LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return ical.ImportOptions{}, err
+	}
+	return ical.ImportOptions{
+		HouseholdID: req.GetHouseholdId(),
+		EntityType:  req.GetEntityType(),
+		EntityID:    req.GetEntityId(),
+		Location:    loc,
+		DryRun:      req.GetDryRun(),
+	}, nil
+}
+
+func ImportResultToProto(result ical.ImportResult, dryRun bool, loc *time.Location) *proto.ImportEventsResponse {
+	resp := &proto.ImportEventsResponse{
+		Created:   int32(result.Created),
+		Updated:   int32(result.Updated),
+		Unchanged: int32(result.Unchanged),
+		DryRun:    dryRun,
+	}
+	for _, c := range result.Changes {
+		resp.Events = append(resp.Events, &proto.ImportedEvent{
+			Action: string(c.Action),
+			Uid:    c.Event.ExternalUID,
+			Event:  EventToProto(c.Event, loc),
+		})
+	}
+	for _, p := range result.Problems {
+		resp.Problems = append(resp.Problems, &proto.ImportProblem{Uid: p.UID, Line: int32(p.Line), Message: p.Err.Error()})
+	}
+	return resp
+}
+
+func EventError(err error) *proto.EventResponse {
+	return &proto.EventResponse{ErrorMessage: Error(err)}
+}
+
+func EventsError(err error) *proto.EventsResponse {
+	return &proto.EventsResponse{ErrorMessage: Error(err)}
+}
+
+func ImportEventsError(err error) *proto.ImportEventsResponse {
+	return &proto.ImportEventsResponse{ErrorMessage: Error(err)}
+}
+
+// formatEventTime writes all-day dates as the date in t's own zone, which is
+// UTC as stored and loc after domain.Event.In.
+func formatEventTime(t time.Time, allDay bool, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	if allDay {
+		return t.Format(utils.DateLayout)
+	}
+	return utils.FormatTimeIn(t, loc)
+}
+
+type eventTimes struct {
+	start, end   string
+	exDates      []string
+	recurrenceID string
+}
+
+func setEventTimes(e *domain.Event, ts eventTimes, loc *time.Location) error {
+	parse := func(field, s string) (time.Time, error) {
+		var (
+			t   time.Time
+			err error
+		)
+		if e.AllDay {
+			t, err = utils.ParseDate(s)
+		} else {
+			t, err = utils.ParseTime(s, loc)
+		}
+		if err != nil {
+			return t, fmt.Errorf("%s: %w", field, err)
+		}
+		return t, nil
+	}
+
+	var err error
+	if e.StartDate, err = parse("start_date", ts.start); err != nil {
+		return err
+	}
+	if e.EndDate, err = parse("end_date", ts.end); err != nil {
+		return err
+	}
+	if e.EndDate.IsZero() && !e.StartDate.IsZero() {
+		e.EndDate = e.StartDate
+		if e.AllDay {
+			e.EndDate = e.StartDate.AddDate(0, 0, 1)
+		}
+	}
+	for _, s := range ts.exDates {
+		t, err := parse("exdates", s)
+		if err != nil {
+			return err
+		}
+		e.ExDates = append(e.ExDates, t)
+	}
+	if ts.recurrenceID != "" {
+		rid, err := parse("recurrence_id", ts.recurrenceID)
+		if err != nil {
+			return err
+		}
+		e.RecurrenceID = &rid
+	}
+	return nil
+}
+
+type eventQuery struct {
+	entityType, entityID, householdID, assignedTo string
+	start, end, timeZone                          string
+	offset, limit                                 int32
+}
+
+func eventFilter(q eventQuery) (repository.EventFilter, error) {
+	loc, err := utils.LoadLocation(q.timeZone)
+	if err != nil {
+		return repository.EventFilter{}, err
+	}
+	f := repository.EventFilter{
+		Location:    loc,
+		HouseholdID: q.householdID,
+		EntityType:  q.entityType,
+		EntityID:    q.entityID,
+		AssignedTo:  q.assignedTo,
+		Offset:      int(q.offset),
+		Limit:       int(q.limit),
+	}
+	if f.From, err = utils.ParseTime(q.start, loc); err != nil {
+		return f, fmt.Errorf("start: %w", err)
+	}
+	if f.To, err = utils.ParseTime(q.end, loc); err != nil {
+		return f, fmt.Errorf("end: %w", err)
+	}
+	if !f.From.IsZero() && !f.To.IsZero() && !f.From.Before(f.To) {
+		return f, fmt.Errorf("%w: start must be before end", ErrInvalidArgument)
+	}
+	return f, nil
+}
@@ -0,0 +1,181 @@
+package mapper
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/ical"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent_RoundTrip(t *testing.T) {
+	start := time.Date(2025, 5, 5, 17, 0, 0, 0, time.UTC)
+	rid := start.AddDate(0, 0, 7)
+	events := []domain.Event{
+		{
+			BaseModel: base, Name: "Dinner", EntityID: "m1", EntityType: "meal", HouseholdID: "h1",
+			StartDate: start, EndDate: start.Add(time.Hour), AssignedTo: "u1",
+			RRule: "FREQ=WEEKLY", ExDates: domain.TimeList{start.AddDate(0, 0, 14)},
+		},
+		{BaseModel: base, Name: "Late dinner", SeriesID: "s1", RecurrenceID: &rid, StartDate: rid.Add(time.Hour), EndDate: rid.Add(2 * time.Hour)},
+		{BaseModel: base, Name: "Holiday", AllDay: true, StartDate: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 8, 8, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, e := range events {
+		t.Run(e.Name, func(t *testing.T) {
+			got, err := EventFromProto(EventToProto(e, nil))
+			require.NoError(t, err)
+			assert.Equal(t, e, got)
+		})
+	}
+}
+
+func TestEventToProto_Formatting(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2025, 5, 5, 17, 0, 0, 0, time.UTC)
+
+	resp := EventToProto(domain.Event{StartDate: start, EndDate: start.Add(time.Hour)}, tokyo)
+	assert.Equal(t, "2025-05-06T02:00:00+09:00", resp.GetStartDate())
+	assert.Empty(t, resp.GetCreatedAt(), "zero times are empty")
+	assert.Empty(t, resp.GetRecurrenceId())
+
+	day := domain.Event{AllDay: true, StartDate: time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC)}
+	resp = EventToProto(day, tokyo)
+	assert.Equal(t, "2025-05-05", resp.GetStartDate(), "all-day dates do not move with the zone")
+	assert.Equal(t, "2025-05-05", EventToProto(day.In(tokyo), tokyo).GetStartDate())
+}
+
+func TestEventFromCreate(t *testing.T) {
+	london, err := utils.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+
+	e, err := EventFromCreate(&proto.CreateEventRequest{
+		Name: "Dinner", HouseholdId: "h1", StartDate: "2025-07-04T18:00:00", EndDate: "2025-07-04T19:00:00Z",
+		Rrule: "FREQ=WEEKLY", Exdates: []string{"2025-07-11T18:00:00"},
+	}, london)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 7, 4, 17, 0, 0, 0, time.UTC), e.StartDate, "wall clock times are read in the household's zone")
+	assert.Equal(t, time.Date(2025, 7, 4, 19, 0, 0, 0, time.UTC), e.EndDate)
+	assert.Equal(t, domain.TimeList{time.Date(2025, 7, 11, 17, 0, 0, 0, time.UTC)}, e.ExDates)
+
+	e, err = EventFromCreate(&proto.CreateEventRequest{AllDay: true, StartDate: "2025-07-04"}, london)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC), e.StartDate)
+	assert.Equal(t, time.Date(2025, 7, 5, 0, 0, 0, 0, time.UTC), e.EndDate)
+
+	for name, req := range map[string]*proto.CreateEventRequest{
+		"no start":      {Name: "x"},
+		"bad start":     {StartDate: "July 4th"},
+		"end first":     {StartDate: "2025-07-04T18:00:00Z", EndDate: "2025-07-04T17:00:00Z"},
+		"bad rule":      {StartDate: "2025-07-04T18:00:00Z", Rrule: "FREQ=SECONDLY"},
+		"bad exception": {StartDate: "2025-07-04T18:00:00Z", Exdates: []string{"soon"}},
+	} {
+		_, err := EventFromCreate(req, nil)
+		assert.Error(t, err, name)
+		assert.Equal(t, int32(400), Error(err).GetCode(), name)
+	}
+}
+
+func TestEventFromUpdate(t *testing.T) {
+	e, err := EventFromUpdate(&proto.UpdateEventRequest{
+		Id: "e1", Name: "Dinner", StartDate: "2025-07-12T18:00:00Z", EndDate: "2025-07-12T19:00:00Z",
+		RecurrenceId: "2025-07-11T18:00:00Z", Scope: proto.EditScope_EDIT_SCOPE_THIS,
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "e1", e.ID)
+	require.NotNil(t, e.RecurrenceID)
+	assert.Equal(t, time.Date(2025, 7, 11, 18, 0, 0, 0, time.UTC), *e.RecurrenceID)
+}
+
+func TestOccurrencesToProto(t *testing.T) {
+	start := time.Date(2025, 5, 5, 17, 0, 0, 0, time.UTC)
+	series := domain.Event{BaseModel: domain.BaseModel{ID: "s1"}, StartDate: start, EndDate: start.Add(time.Hour), RRule: "FREQ=DAILY"}
+	occurrences, err := series.Occurrences(start, start.AddDate(0, 0, 2))
+	require.NoError(t, err)
+
+	resp := OccurrencesToProto(occurrences, nil)
+	require.Len(t, resp.GetEvents(), 2)
+	assert.Equal(t, "s1", resp.GetEvents()[1].GetSeriesId())
+	assert.Equal(t, "2025-05-06T17:00:00Z", resp.GetEvents()[1].GetRecurrenceId())
+	assert.Equal(t, "2025-05-06T17:00:00Z", resp.GetEvents()[1].GetStartDate())
+}
+
+func TestEventFilters(t *testing.T) {
+	household, zone, start, end := "h1", "Asia/Tokyo", "2025-05-05", "2025-05-06"
+	limit := int32(10)
+	f, err := EventFilterFromGetEvents(&proto.GetEventsRequest{HouseholdId: &household, TimeZone: &zone, Start: &start, End: &end, Limit: &limit})
+	if errors.Is(err, utils.ErrInvalidTime) {
+		t.Skip("no tzdata:", err)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, "h1", f.HouseholdID)
+	assert.Equal(t, 10, f.Limit)
+	assert.Equal(t, time.Date(2025, 5, 4, 15, 0, 0, 0, time.UTC), f.From, "dates are midnight in the zone")
+	assert.Equal(t, "Asia/Tokyo", f.Location.String())
+
+	f, err = EventFilterFromGetEvents(&proto.GetEventsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, repository.EventFilter{Location: time.UTC}, f)
+
+	_, err = EventFilterFromGetEvents(&proto.GetEventsRequest{Start: &end, End: &start})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	_, err = EventFilterFromListOccurrences(&proto.ListOccurrencesRequest{Start: start})
+	assert.ErrorIs(t, err, repository.ErrUnboundedWindow)
+	f, err = EventFilterFromListOccurrences(&proto.ListOccurrencesRequest{Start: start, End: end, HouseholdId: &household})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC), f.To)
+}
+
+type memoryStore struct{ events []domain.Event }
+
+func (s *memoryStore) Find(context.Context, repository.EventFilter) ([]domain.Event, error) {
+	return nil, nil
+}
+
+func (s *memoryStore) Create(_ context.Context, e *domain.Event) (*domain.Event, error) {
+	e.ID = "new"
+	s.events = append(s.events, *e)
+	return e, nil
+}
+
+func (s *memoryStore) Update(_ context.Context, _ string, e *domain.Event) (*domain.Event, error) {
+	return e, nil
+}
+
+func TestImport(t *testing.T) {
+	_, err := ImportOptionsFromRequest(&proto.ImportEventsRequest{})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	req := &proto.ImportEventsRequest{
+		HouseholdId: "h1", DryRun: true,
+		Ics: "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nSUMMARY:Swim\nDTSTART:20250505T090000Z\nEND:VEVENT\n" +
+			"BEGIN:VEVENT\nSUMMARY:no uid\nDTSTART:20250505T090000Z\nEND:VEVENT\nEND:VCALENDAR\n",
+	}
+	opts, err := ImportOptionsFromRequest(req)
+	require.NoError(t, err)
+	result, err := ical.Import(context.Background(), &memoryStore{}, strings.NewReader(req.GetIcs()), opts)
+	require.NoError(t, err)
+
+	resp := ImportResultToProto(result, opts.DryRun, nil)
+	assert.True(t, resp.GetDryRun())
+	assert.Equal(t, int32(1), resp.GetCreated())
+	require.Len(t, resp.GetEvents(), 1)
+	assert.Equal(t, "create", resp.GetEvents()[0].GetAction())
+	assert.Equal(t, "a", resp.GetEvents()[0].GetUid())
+	assert.Equal(t, "2025-05-05T09:00:00Z", resp.GetEvents()[0].GetEvent().GetStartDate())
+	require.Len(t, resp.GetProblems(), 1)
+	assert.Equal(t, int32(7), resp.GetProblems()[0].GetLine())
+
+	_, err = ical.Import(context.Background(), &memoryStore{}, strings.NewReader("garbage"), opts)
+	assert.Equal(t, int32(400), ImportEventsError(err).GetErrorMessage().GetCode())
+}
@@ -0,0 +1,61 @@
+package mapper
+
+import (
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+)
+
+func HouseholdToProto(h domain.Household) *proto.HouseholdResponse {
+	return &proto.HouseholdResponse{
+		Id:        h.ID,
+		Name:      h.Name,
+		TimeZone:  h.TimeZone,
+		CreatedAt: formatTime(h.CreatedAt),
+		UpdatedAt: formatTime(h.UpdatedAt),
+	}
+}
+
+func HouseholdsToProto(households []domain.Household) *proto.HouseholdsResponse {
+	resp := &proto.HouseholdsResponse{Households: make([]*proto.HouseholdResponse, len(households))}
+	for i, h := range households {
+		resp.Households[i] = HouseholdToProto(h)
+	}
+	return resp
+}
+
+// HouseholdFromProto is the inverse of HouseholdToProto.
+func HouseholdFromProto(resp *proto.HouseholdResponse) (domain.Household, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	return domain.Household{BaseModel: base, Name: resp.GetName(), TimeZone: resp.GetTimeZone()}, err
+}
+
+func HouseholdFromCreate(req *proto.CreateHouseholdRequest) (domain.Household, error) {
+	h := domain.Household{Name: req.GetName(), TimeZone: req.GetTimeZone()}
+	if _, err := h.Location(); err != nil {
+		return h, err
+	}
+	return h, nil
+}
+
+// ApplyHouseholdUpdate changes h as req asks. The time zone is only changed
+// if req sets it.
+func ApplyHouseholdUpdate(h *domain.Household, req *proto.UpdateHouseholdRequest) error {
+	updated := *h
+	updated.Name = req.GetName()
+	if req.TimeZone != nil {
+		updated.TimeZone = req.GetTimeZone()
+	}
+	if _, err := updated.Location(); err != nil {
+		return err
+	}
+	*h = updated
+	return nil
+}
+
+func HouseholdError(err error) *proto.HouseholdResponse {
+	return &proto.HouseholdResponse{ErrorMessage: Error(err)}
+}
+
+func HouseholdsError(err error) *proto.HouseholdsResponse {
+	return &proto.HouseholdsResponse{ErrorMessage: Error(err)}
+}
@@ -0,0 +1,59 @@
+package mapper
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHousehold_RoundTrip(t *testing.T) {
+	h := domain.Household{BaseModel: base, Name: "The Smiths", TimeZone: "Europe/London"}
+
+	resp := HouseholdToProto(h)
+	assert.Equal(t, "2025-05-01T08:00:00Z", resp.GetCreatedAt())
+	assert.Equal(t, "Europe/London", resp.GetTimeZone())
+	assert.Nil(t, resp.GetErrorMessage())
+
+	got, err := HouseholdFromProto(resp)
+	require.NoError(t, err)
+	assert.Equal(t, h, got)
+
+	zero, err := HouseholdFromProto(HouseholdToProto(domain.Household{Name: "New"}))
+	require.NoError(t, err)
+	assert.True(t, zero.CreatedAt.IsZero())
+
+	list := HouseholdsToProto([]domain.Household{h, h})
+	assert.Len(t, list.GetHouseholds(), 2)
+}
+
+func TestHouseholdFromCreate(t *testing.T) {
+	h, err := HouseholdFromCreate(&proto.CreateHouseholdRequest{Name: "Home"})
+	require.NoError(t, err)
+	assert.Equal(t, domain.Household{Name: "Home"}, h)
+
+	zone := "Mars/Olympus"
+	_, err = HouseholdFromCreate(&proto.CreateHouseholdRequest{Name: "Home", TimeZone: &zone})
+	assert.True(t, errors.Is(err, domain.ErrInvalidTimeZone))
+	assert.Equal(t, int32(http.StatusBadRequest), HouseholdError(err).GetErrorMessage().GetCode())
+}
+
+func TestApplyHouseholdUpdate(t *testing.T) {
+	h := domain.Household{BaseModel: base, Name: "Home", TimeZone: "UTC"}
+
+	require.NoError(t, ApplyHouseholdUpdate(&h, &proto.UpdateHouseholdRequest{Id: "id-1", Name: "House"}))
+	assert.Equal(t, "House", h.Name)
+	assert.Equal(t, "UTC", h.TimeZone, "unset time zone leaves it alone")
+
+	empty := ""
+	require.NoError(t, ApplyHouseholdUpdate(&h, &proto.UpdateHouseholdRequest{Name: "House", TimeZone: &empty}))
+	assert.Equal(t, "", h.TimeZone, "empty time zone resets it to UTC")
+
+	bad := "Nowhere"
+	assert.Error(t, ApplyHouseholdUpdate(&h, &proto.UpdateHouseholdRequest{Name: "Other", TimeZone: &bad}))
+	assert.Equal(t, "House", h.Name, "a failed update leaves the household alone")
+}
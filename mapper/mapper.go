@@ -0,0 +1,99 @@
+// Package mapper converts between domain models and the v1 proto messages,
+// whose times are strings. Times are written as RFC 3339 in UTC, except that
+// event times can be written in a household's zone and all-day dates are
+// written as YYYY-MM-DD. See mapper/v2 for the Timestamp-based messages.
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/utils"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidArgument wraps the errors of requests that cannot be converted.
+// It is domain.ErrInvalidArgument, which the errors of packages such as
+// repository, rotation and ical wrap too.
+var ErrInvalidArgument = domain.ErrInvalidArgument
+
+// badRequest lists errors that mean the client sent something invalid.
+var badRequest = []error{
+	ErrInvalidArgument,
+	utils.ErrInvalidTime,
+	domain.ErrInvalidRRule,
+	domain.ErrInvalidTimeZone,
+	domain.ErrNotRecurring,
+	domain.ErrNotAnOccurrence,
+	domain.ErrInvalidMealSlot,
+	domain.ErrInvalidRecipe,
+	domain.ErrInvalidShoppingList,
+}
+
+// conflict lists errors that mean the request clashes with stored data.
+var conflict = []error{
+	domain.ErrRecipeInUse,
+}
+
+// Error converts err to an Error message with an HTTP-style code. Errors
+// without a known cause are reported as internal, without their text, so
+// that database details do not reach clients. A nil err is nil.
+func Error(err error) *proto.Error {
+	if err == nil {
+		return nil
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return &proto.Error{Code: http.StatusNotFound, Message: "not found"}
+	case isAny(err, badRequest):
+		return &proto.Error{Code: http.StatusBadRequest, Message: err.Error()}
+	case isAny(err, conflict):
+		return &proto.Error{Code: http.StatusConflict, Message: err.Error()}
+	default:
+		return &proto.Error{Code: http.StatusInternalServerError, Message: "internal error"}
+	}
+}
+
+func isAny(err error, targets []error) bool {
+	for _, target := range targets {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTime(t time.Time) string {
+	return utils.FormatTimeIn(t, time.UTC)
+}
+
+// formatDate writes a date stored as midnight UTC, such as a meal plan day.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(utils.DateLayout)
+}
+
+// parseTime parses a time written by formatTime, naming field in errors.
+func parseTime(field, s string) (time.Time, error) {
+	t, err := utils.ParseTime(s, time.UTC)
+	if err != nil {
+		return t, fmt.Errorf("%s: %w", field, err)
+	}
+	return t, nil
+}
+
+func baseModel(id, createdAt, updatedAt string) (domain.BaseModel, error) {
+	m := domain.BaseModel{ID: id}
+	var err error
+	if m.CreatedAt, err = parseTime("created_at", createdAt); err != nil {
+		return m, err
+	}
+	m.UpdatedAt, err = parseTime("updated_at", updatedAt)
+	return m, err
+}
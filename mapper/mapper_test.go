@@ -0,0 +1,38 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+var (
+	created = time.Date(2025, 5, 1, 8, 0, 0, 0, time.UTC)
+	updated = time.Date(2025, 5, 2, 9, 30, 0, 0, time.UTC)
+	base    = domain.BaseModel{ID: "id-1", CreatedAt: created, UpdatedAt: updated}
+)
+
+func TestError(t *testing.T) {
+	assert.Nil(t, Error(nil))
+
+	notFound := Error(fmt.Errorf("household h1: %w", gorm.ErrRecordNotFound))
+	assert.Equal(t, int32(http.StatusNotFound), notFound.GetCode())
+	assert.Equal(t, "not found", notFound.GetMessage())
+
+	bad := Error(fmt.Errorf("rrule: %w", domain.ErrInvalidRRule))
+	assert.Equal(t, int32(http.StatusBadRequest), bad.GetCode())
+	assert.Contains(t, bad.GetMessage(), "rrule")
+
+	inUse := Error(fmt.Errorf("%w: 2 meal plan entries", domain.ErrRecipeInUse))
+	assert.Equal(t, int32(http.StatusConflict), inUse.GetCode())
+
+	internal := Error(errors.New("pq: connection refused to 10.0.0.1"))
+	assert.Equal(t, int32(http.StatusInternalServerError), internal.GetCode())
+	assert.NotContains(t, internal.GetMessage(), "10.0.0.1")
+}
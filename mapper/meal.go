@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+)
+
+func MealToProto(m domain.Meal) *proto.MealResponse {
+	return &proto.MealResponse{
+		Id:          m.ID,
+		Name:        m.Name,
+		HouseholdId: m.HouseholdID,
+		CreatedAt:   formatTime(m.CreatedAt),
+		UpdatedAt:   formatTime(m.UpdatedAt),
+	}
+}
+
+func MealsToProto(meals []domain.Meal) *proto.MealsResponse {
+	resp := &proto.MealsResponse{Meals: make([]*proto.MealResponse, len(meals))}
+	for i, m := range meals {
+		resp.Meals[i] = MealToProto(m)
+	}
+	return resp
+}
+
+// MealFromProto is the inverse of MealToProto.
+func MealFromProto(resp *proto.MealResponse) (domain.Meal, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	return domain.Meal{BaseModel: base, Name: resp.GetName(), HouseholdID: resp.GetHouseholdId()}, err
+}
+
+func MealFromCreate(req *proto.CreateMealRequest) domain.Meal {
+	return domain.Meal{Name: req.GetName(), HouseholdID: req.GetHouseholdId()}
+}
+
+// ApplyMealUpdate changes m as req asks. An empty household ID leaves m's
+// unchanged.
+func ApplyMealUpdate(m *domain.Meal, req *proto.UpdateMealRequest) {
+	m.Name = req.GetName()
+	if req.GetHouseholdId() != "" {
+		m.HouseholdID = req.GetHouseholdId()
+	}
+}
+
+func MealError(err error) *proto.MealResponse {
+	return &proto.MealResponse{ErrorMessage: Error(err)}
+}
+
+func MealsError(err error) *proto.MealsResponse {
+	return &proto.MealsResponse{ErrorMessage: Error(err)}
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMeal_RoundTrip(t *testing.T) {
+	m := domain.Meal{BaseModel: base, Name: "Lasagne", HouseholdID: "h1"}
+
+	got, err := MealFromProto(MealToProto(m))
+	require.NoError(t, err)
+	assert.Equal(t, m, got)
+	assert.Len(t, MealsToProto([]domain.Meal{m, m}).GetMeals(), 2)
+	assert.Empty(t, MealsToProto(nil).GetMeals())
+}
+
+func TestMealRequests(t *testing.T) {
+	m := MealFromCreate(&proto.CreateMealRequest{Name: "Soup", HouseholdId: "h1"})
+	assert.Equal(t, domain.Meal{Name: "Soup", HouseholdID: "h1"}, m)
+
+	ApplyMealUpdate(&m, &proto.UpdateMealRequest{Name: "Stew"})
+	assert.Equal(t, domain.Meal{Name: "Stew", HouseholdID: "h1"}, m)
+
+	resp := MealError(gorm.ErrRecordNotFound)
+	assert.Equal(t, int32(404), resp.GetErrorMessage().GetCode())
+	assert.Empty(t, resp.GetId())
+	assert.Equal(t, int32(404), MealsError(gorm.ErrRecordNotFound).GetErrorMessage().GetCode())
+}
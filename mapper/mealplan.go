@@ -0,0 +1,167 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/utils"
+)
+
+var mealSlots = map[proto.MealSlot]domain.MealSlot{
+	proto.MealSlot_MEAL_SLOT_BREAKFAST: domain.Breakfast,
+	proto.MealSlot_MEAL_SLOT_LUNCH:     domain.Lunch,
+	proto.MealSlot_MEAL_SLOT_DINNER:    domain.Dinner,
+	proto.MealSlot_MEAL_SLOT_SNACK:     domain.Snack,
+}
+
+func mealSlot(s proto.MealSlot) (domain.MealSlot, error) {
+	slot, ok := mealSlots[s]
+	if !ok {
+		return "", fmt.Errorf("%w %v", domain.ErrInvalidMealSlot, s)
+	}
+	return slot, nil
+}
+
+func mealSlotToProto(slot domain.MealSlot) proto.MealSlot {
+	for s, ds := range mealSlots {
+		if ds == slot {
+			return s
+		}
+	}
+	return proto.MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func MealPlanEntryToProto(e domain.MealPlanEntry) *proto.MealPlanEntryResponse {
+	resp := &proto.MealPlanEntryResponse{
+		Id:          e.ID,
+		HouseholdId: e.HouseholdID,
+		Date:        formatDate(e.Date),
+		Slot:        mealSlotToProto(e.Slot),
+		RecipeId:    e.RecipeID,
+		Servings:    int32(e.Servings),
+		Note:        e.Note,
+		CreatedAt:   formatTime(e.CreatedAt),
+		UpdatedAt:   formatTime(e.UpdatedAt),
+	}
+	if e.Recipe != nil {
+		resp.Recipe = RecipeToProto(*e.Recipe)
+	}
+	return resp
+}
+
+func MealPlanToProto(entries []domain.MealPlanEntry) *proto.MealPlanResponse {
+	resp := &proto.MealPlanResponse{Entries: make([]*proto.MealPlanEntryResponse, len(entries))}
+	for i, e := range entries {
+		resp.Entries[i] = MealPlanEntryToProto(e)
+	}
+	return resp
+}
+
+// MealPlanEntryFromProto is the inverse of MealPlanEntryToProto.
+func MealPlanEntryFromProto(resp *proto.MealPlanEntryResponse) (domain.MealPlanEntry, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	if err != nil {
+		return domain.MealPlanEntry{}, err
+	}
+	e := domain.MealPlanEntry{BaseModel: base, HouseholdID: resp.GetHouseholdId()}
+	if err := setMealPlanEntry(&e, entryFields{
+		date: resp.GetDate(), slot: resp.GetSlot(), recipeID: resp.GetRecipeId(), servings: resp.GetServings(), note: resp.GetNote(),
+	}); err != nil {
+		return e, err
+	}
+	if resp.Recipe != nil {
+		r, err := RecipeFromProto(resp.GetRecipe())
+		if err != nil {
+			return e, err
+		}
+		e.Recipe = &r
+	}
+	return e, nil
+}
+
+func MealPlanEntryFromCreate(req *proto.CreateMealPlanEntryRequest) (domain.MealPlanEntry, error) {
+	e := domain.MealPlanEntry{HouseholdID: req.GetHouseholdId()}
+	err := setMealPlanEntry(&e, entryFields{
+		date: req.GetDate(), slot: req.GetSlot(), recipeID: req.GetRecipeId(), servings: req.GetServings(), note: req.GetNote(),
+	})
+	return e, err
+}
+
+// ApplyMealPlanEntryUpdate replaces e's details with req's. The household is
+// unchanged, and so is e if req is invalid.
+func ApplyMealPlanEntryUpdate(e *domain.MealPlanEntry, req *proto.UpdateMealPlanEntryRequest) error {
+	updated := *e
+	updated.Recipe = nil
+	if err := setMealPlanEntry(&updated, entryFields{
+		date: req.GetDate(), slot: req.GetSlot(), recipeID: req.GetRecipeId(), servings: req.GetServings(), note: req.GetNote(),
+	}); err != nil {
+		return err
+	}
+	*e = updated
+	return nil
+}
+
+// MealPlanFilterFromRequest converts a plan request, whose days must be
+// bounded.
+func MealPlanFilterFromRequest(req *proto.GetMealPlanRequest) (repository.MealPlanFilter, error) {
+	if req.GetHouseholdId() == "" {
+		return repository.MealPlanFilter{}, fmt.Errorf("%w: household_id is required", ErrInvalidArgument)
+	}
+	f := repository.MealPlanFilter{HouseholdID: req.GetHouseholdId()}
+	var err error
+	if f.From, err = utils.ParseDate(req.GetStart()); err != nil {
+		return f, fmt.Errorf("start: %w", err)
+	}
+	if f.To, err = utils.ParseDate(req.GetEnd()); err != nil {
+		return f, fmt.Errorf("end: %w", err)
+	}
+	if f.From.IsZero() || f.To.IsZero() || !f.From.Before(f.To) {
+		return f, fmt.Errorf("%w: start and end dates are required, start first", ErrInvalidArgument)
+	}
+	if req.Slot != nil {
+		if f.Slot, err = mealSlot(req.GetSlot()); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
+
+func MealPlanEntryError(err error) *proto.MealPlanEntryResponse {
+	return &proto.MealPlanEntryResponse{ErrorMessage: Error(err)}
+}
+
+func MealPlanError(err error) *proto.MealPlanResponse {
+	return &proto.MealPlanResponse{ErrorMessage: Error(err)}
+}
+
+type entryFields struct {
+	date, recipeID, note string
+	slot                 proto.MealSlot
+	servings             int32
+}
+
+func setMealPlanEntry(e *domain.MealPlanEntry, f entryFields) error {
+	date, err := utils.ParseDate(f.date)
+	if err != nil {
+		return fmt.Errorf("date: %w", err)
+	}
+	if date.IsZero() {
+		return fmt.Errorf("%w: date is required", ErrInvalidArgument)
+	}
+	if f.recipeID == "" {
+		return fmt.Errorf("%w: recipe_id is required", ErrInvalidArgument)
+	}
+	if f.servings < 0 {
+		return fmt.Errorf("%w: servings cannot be negative", ErrInvalidArgument)
+	}
+	if e.Slot, err = mealSlot(f.slot); err != nil {
+		return err
+	}
+	e.Date = date
+	e.RecipeID = f.recipeID
+	e.Servings = int(f.servings)
+	e.Note = f.note
+	return nil
+}
@@ -0,0 +1,70 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMealPlanEntry_RoundTrip(t *testing.T) {
+	recipe := domain.Recipe{BaseModel: base, HouseholdID: "h1", Name: "Pancakes", Servings: 4}
+	e := domain.MealPlanEntry{
+		BaseModel: base, HouseholdID: "h1", Date: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC),
+		Slot: domain.Breakfast, RecipeID: recipe.ID, Recipe: &recipe, Servings: 2, Note: "with berries",
+	}
+	resp := MealPlanEntryToProto(e)
+	assert.Equal(t, "2025-05-06", resp.GetDate())
+	assert.Equal(t, proto.MealSlot_MEAL_SLOT_BREAKFAST, resp.GetSlot())
+	assert.Equal(t, "Pancakes", resp.GetRecipe().GetName())
+
+	got, err := MealPlanEntryFromProto(resp)
+	require.NoError(t, err)
+	assert.Equal(t, e, got)
+	assert.Len(t, MealPlanToProto([]domain.MealPlanEntry{e}).GetEntries(), 1)
+}
+
+func TestMealPlanEntryRequests(t *testing.T) {
+	e, err := MealPlanEntryFromCreate(&proto.CreateMealPlanEntryRequest{
+		HouseholdId: "h1", Date: "2025-05-06", Slot: proto.MealSlot_MEAL_SLOT_DINNER, RecipeId: "r1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.MealPlanEntry{HouseholdID: "h1", Date: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC), Slot: domain.Dinner, RecipeID: "r1"}, e)
+
+	for name, req := range map[string]*proto.CreateMealPlanEntryRequest{
+		"no date":     {Slot: proto.MealSlot_MEAL_SLOT_DINNER, RecipeId: "r1"},
+		"bad date":    {Date: "Tuesday", Slot: proto.MealSlot_MEAL_SLOT_DINNER, RecipeId: "r1"},
+		"no slot":     {Date: "2025-05-06", RecipeId: "r1"},
+		"no recipe":   {Date: "2025-05-06", Slot: proto.MealSlot_MEAL_SLOT_DINNER},
+		"bad portion": {Date: "2025-05-06", Slot: proto.MealSlot_MEAL_SLOT_DINNER, RecipeId: "r1", Servings: -2},
+	} {
+		_, err := MealPlanEntryFromCreate(req)
+		assert.Equal(t, int32(400), Error(err).GetCode(), name)
+	}
+
+	before := e
+	assert.Error(t, ApplyMealPlanEntryUpdate(&e, &proto.UpdateMealPlanEntryRequest{Date: "2025-05-07"}))
+	assert.Equal(t, before, e, "an invalid update changes nothing")
+	require.NoError(t, ApplyMealPlanEntryUpdate(&e, &proto.UpdateMealPlanEntryRequest{
+		Date: "2025-05-07", Slot: proto.MealSlot_MEAL_SLOT_LUNCH, RecipeId: "r2", Servings: 3,
+	}))
+	assert.Equal(t, domain.Lunch, e.Slot)
+	assert.Equal(t, "h1", e.HouseholdID)
+}
+
+func TestMealPlanFilterFromRequest(t *testing.T) {
+	slot := proto.MealSlot_MEAL_SLOT_DINNER
+	f, err := MealPlanFilterFromRequest(&proto.GetMealPlanRequest{HouseholdId: "h1", Start: "2025-05-05", End: "2025-05-12", Slot: &slot})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC), f.To)
+	assert.Equal(t, domain.Dinner, f.Slot)
+
+	_, err = MealPlanFilterFromRequest(&proto.GetMealPlanRequest{HouseholdId: "h1", Start: "2025-05-05"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = MealPlanFilterFromRequest(&proto.GetMealPlanRequest{Start: "2025-05-05", End: "2025-05-12"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Equal(t, int32(400), MealPlanError(err).GetErrorMessage().GetCode())
+}
@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+)
+
+func MemberToProto(m domain.Member) *proto.MemberResponse {
+	return &proto.MemberResponse{
+		Id:          m.ID,
+		HouseholdId: m.HouseholdID,
+		UserId:      m.UserID,
+		CreatedAt:   formatTime(m.CreatedAt),
+		UpdatedAt:   formatTime(m.UpdatedAt),
+	}
+}
+
+func MembersToProto(members []domain.Member) *proto.MembersResponse {
+	resp := &proto.MembersResponse{Members: make([]*proto.MemberResponse, len(members))}
+	for i, m := range members {
+		resp.Members[i] = MemberToProto(m)
+	}
+	return resp
+}
+
+// MemberFromProto is the inverse of MemberToProto.
+func MemberFromProto(resp *proto.MemberResponse) (domain.Member, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	return domain.Member{BaseModel: base, HouseholdID: resp.GetHouseholdId(), UserID: resp.GetUserId()}, err
+}
+
+func MemberFromCreate(req *proto.CreateMemberRequest) domain.Member {
+	return domain.Member{HouseholdID: req.GetHouseholdId(), UserID: req.GetUserId()}
+}
+
+// ApplyMemberUpdate changes m as req asks. Empty IDs leave m's unchanged.
+func ApplyMemberUpdate(m *domain.Member, req *proto.UpdateMemberRequest) {
+	if req.GetHouseholdId() != "" {
+		m.HouseholdID = req.GetHouseholdId()
+	}
+	if req.GetUserId() != "" {
+		m.UserID = req.GetUserId()
+	}
+}
+
+func MemberError(err error) *proto.MemberResponse {
+	return &proto.MemberResponse{ErrorMessage: Error(err)}
+}
+
+func MembersError(err error) *proto.MembersResponse {
+	return &proto.MembersResponse{ErrorMessage: Error(err)}
+}
@@ -0,0 +1,36 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestMember_RoundTrip(t *testing.T) {
+	m := domain.Member{BaseModel: base, HouseholdID: "h1", UserID: "u1"}
+
+	resp := MemberToProto(m)
+	assert.Equal(t, "2025-05-02T09:30:00Z", resp.GetUpdatedAt())
+
+	got, err := MemberFromProto(resp)
+	require.NoError(t, err)
+	assert.Equal(t, m, got)
+	assert.Len(t, MembersToProto([]domain.Member{m}).GetMembers(), 1)
+
+	_, err = MemberFromProto(&proto.MemberResponse{CreatedAt: "yesterday"})
+	assert.ErrorContains(t, err, "created_at")
+}
+
+func TestMemberRequests(t *testing.T) {
+	m := MemberFromCreate(&proto.CreateMemberRequest{HouseholdId: "h1", UserId: "u1"})
+	assert.Equal(t, domain.Member{HouseholdID: "h1", UserID: "u1"}, m)
+
+	ApplyMemberUpdate(&m, &proto.UpdateMemberRequest{HouseholdId: "h2"})
+	assert.Equal(t, domain.Member{HouseholdID: "h2", UserID: "u1"}, m)
+
+	assert.Equal(t, int32(404), MembersError(gorm.ErrRecordNotFound).GetErrorMessage().GetCode())
+}
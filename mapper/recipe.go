@@ -0,0 +1,102 @@
+package mapper
+
+import (
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+)
+
+func RecipeToProto(r domain.Recipe) *proto.RecipeResponse {
+	resp := &proto.RecipeResponse{
+		Id:          r.ID,
+		HouseholdId: r.HouseholdID,
+		Name:        r.Name,
+		Description: r.Description,
+		Servings:    int32(r.Servings),
+		Steps:       r.Steps,
+		Tags:        r.Tags,
+		CreatedAt:   formatTime(r.CreatedAt),
+		UpdatedAt:   formatTime(r.UpdatedAt),
+	}
+	for _, in := range r.Ingredients {
+		resp.Ingredients = append(resp.Ingredients, &proto.Ingredient{Name: in.Name, Quantity: in.Quantity, Unit: in.Unit, Note: in.Note})
+	}
+	return resp
+}
+
+func RecipesToProto(recipes []domain.Recipe) *proto.RecipesResponse {
+	resp := &proto.RecipesResponse{Recipes: make([]*proto.RecipeResponse, len(recipes))}
+	for i, r := range recipes {
+		resp.Recipes[i] = RecipeToProto(r)
+	}
+	return resp
+}
+
+// RecipeFromProto is the inverse of RecipeToProto, except that ingredients
+// lose their IDs.
+func RecipeFromProto(resp *proto.RecipeResponse) (domain.Recipe, error) {
+	base, err := baseModel(resp.GetId(), resp.GetCreatedAt(), resp.GetUpdatedAt())
+	r := domain.Recipe{BaseModel: base, HouseholdID: resp.GetHouseholdId()}
+	setRecipe(&r, recipeFields{
+		name: resp.GetName(), description: resp.GetDescription(), servings: resp.GetServings(),
+		ingredients: resp.GetIngredients(), steps: resp.GetSteps(), tags: resp.GetTags(),
+	})
+	return r, err
+}
+
+func RecipeFromCreate(req *proto.CreateRecipeRequest) domain.Recipe {
+	r := domain.Recipe{HouseholdID: req.GetHouseholdId()}
+	setRecipe(&r, recipeFields{
+		name: req.GetName(), description: req.GetDescription(), servings: req.GetServings(),
+		ingredients: req.GetIngredients(), steps: req.GetSteps(), tags: req.GetTags(),
+	})
+	return r
+}
+
+// ApplyRecipeUpdate replaces r's details and ingredients with req's. The
+// household is unchanged.
+func ApplyRecipeUpdate(r *domain.Recipe, req *proto.UpdateRecipeRequest) {
+	setRecipe(r, recipeFields{
+		name: req.GetName(), description: req.GetDescription(), servings: req.GetServings(),
+		ingredients: req.GetIngredients(), steps: req.GetSteps(), tags: req.GetTags(),
+	})
+}
+
+func RecipeFilterFromRequest(req *proto.GetRecipesRequest) repository.RecipeFilter {
+	return repository.RecipeFilter{
+		HouseholdID: req.GetHouseholdId(),
+		Tag:         req.GetTag(),
+		Search:      req.GetSearch(),
+		Offset:      int(req.GetOffset()),
+		Limit:       int(req.GetLimit()),
+	}
+}
+
+func RecipeError(err error) *proto.RecipeResponse {
+	return &proto.RecipeResponse{ErrorMessage: Error(err)}
+}
+
+func RecipesError(err error) *proto.RecipesResponse {
+	return &proto.RecipesResponse{ErrorMessage: Error(err)}
+}
+
+type recipeFields struct {
+	name, description string
+	servings          int32
+	ingredients       []*proto.Ingredient
+	steps, tags       []string
+}
+
+func setRecipe(r *domain.Recipe, f recipeFields) {
+	r.Name = f.name
+	r.Description = f.description
+	r.Servings = int(f.servings)
+	r.Steps = f.steps
+	r.Tags = f.tags
+	r.Ingredients = nil
+	for i, in := range f.ingredients {
+		r.Ingredients = append(r.Ingredients, domain.Ingredient{
+			RecipeID: r.ID, Position: i, Name: in.GetName(), Quantity: in.GetQuantity(), Unit: in.GetUnit(), Note: in.GetNote(),
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestRecipe_RoundTrip(t *testing.T) {
+	r := domain.Recipe{
+		BaseModel: base, HouseholdID: "h1", Name: "Pancakes", Description: "Thin ones", Servings: 4,
+		Steps: domain.StringList{"Whisk", "Fry"}, Tags: domain.StringList{"breakfast"},
+		Ingredients: []domain.Ingredient{
+			{RecipeID: base.ID, Position: 0, Name: "flour", Quantity: 200, Unit: "g"},
+			{RecipeID: base.ID, Position: 1, Name: "salt", Note: "a pinch"},
+		},
+	}
+	got, err := RecipeFromProto(RecipeToProto(r))
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+	assert.Len(t, RecipesToProto([]domain.Recipe{r, r}).GetRecipes(), 2)
+}
+
+func TestRecipeRequests(t *testing.T) {
+	r := RecipeFromCreate(&proto.CreateRecipeRequest{
+		HouseholdId: "h1", Name: "Soup", Servings: 2,
+		Ingredients: []*proto.Ingredient{{Name: "water", Quantity: 1, Unit: "l"}},
+	})
+	assert.Equal(t, "h1", r.HouseholdID)
+	require.Len(t, r.Ingredients, 1)
+	assert.Equal(t, "l", r.Ingredients[0].Unit)
+
+	r.ID = "r1"
+	ApplyRecipeUpdate(&r, &proto.UpdateRecipeRequest{Name: "Stew", Ingredients: []*proto.Ingredient{{Name: "beef"}, {Name: "stock"}}})
+	assert.Equal(t, "Stew", r.Name)
+	assert.Equal(t, "h1", r.HouseholdID)
+	assert.Equal(t, "r1", r.Ingredients[1].RecipeID)
+	assert.Equal(t, 1, r.Ingredients[1].Position)
+
+	tag, limit := "vegan", int32(5)
+	f := RecipeFilterFromRequest(&proto.GetRecipesRequest{HouseholdId: "h1", Tag: &tag, Limit: &limit})
+	assert.Equal(t, repository.RecipeFilter{HouseholdID: "h1", Tag: "vegan", Limit: 5}, f)
+
+	assert.Equal(t, int32(400), RecipeError(domain.ErrInvalidRecipe).GetErrorMessage().GetCode())
+	assert.Equal(t, int32(404), RecipesError(gorm.ErrRecordNotFound).GetErrorMessage().GetCode())
+}
@@ -0,0 +1,132 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/rotation"
+	"github.com/hmlylab/common/utils"
+)
+
+var strategies = map[proto.RotationStrategy]rotation.Strategy{
+	proto.RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN: rotation.RoundRobin,
+	proto.RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD:  rotation.LeastLoad,
+}
+
+// RotationFromRequest converts a rotation request. Its window and the
+// members' unavailability are read in its time zone. For LeastLoad the
+// caller fills in the rotation's Load, e.g. from the household's workload.
+func RotationFromRequest(req *proto.AssignRotationRequest) (rotation.Rotation, rotation.Window, error) {
+	if req.GetEventId() == "" {
+		return rotation.Rotation{}, rotation.Window{}, fmt.Errorf("%w: event_id is required", ErrInvalidArgument)
+	}
+	loc, err := utils.LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return rotation.Rotation{}, rotation.Window{}, err
+	}
+	w, err := window(req.GetStart(), req.GetEnd(), loc)
+	if err != nil {
+		return rotation.Rotation{}, w, err
+	}
+
+	strategy, ok := strategies[req.GetStrategy()]
+	if !ok {
+		return rotation.Rotation{}, w, fmt.Errorf("%w %v", rotation.ErrUnknownStrategy, req.GetStrategy())
+	}
+	r := rotation.Rotation{Strategy: strategy, After: req.GetAfter()}
+	for _, m := range req.GetMembers() {
+		member := rotation.Member{UserID: m.GetUserId()}
+		if member.UserID == "" {
+			return r, w, fmt.Errorf("%w: members need a user_id", ErrInvalidArgument)
+		}
+		for _, p := range m.GetUnavailable() {
+			period, err := window(p.GetStart(), p.GetEnd(), loc)
+			if err != nil {
+				return r, w, fmt.Errorf("unavailable: %w", err)
+			}
+			member.Unavailable = append(member.Unavailable, rotation.Period{Start: period.From, End: period.To})
+		}
+		for _, d := range m.GetExcludedWeekdays() {
+			if d < 0 || d > 6 {
+				return r, w, fmt.Errorf("%w: excluded weekday %d is not between 0 and 6", ErrInvalidArgument, d)
+			}
+			member.ExcludedWeekdays = append(member.ExcludedWeekdays, time.Weekday(d))
+		}
+		r.Members = append(r.Members, member)
+	}
+	if len(r.Members) == 0 {
+		return r, w, rotation.ErrNoMembers
+	}
+	return r, w, nil
+}
+
+func AssignmentsToProto(assignments []rotation.Assignment, dryRun bool, loc *time.Location) *proto.AssignRotationResponse {
+	resp := &proto.AssignRotationResponse{DryRun: dryRun}
+	for _, a := range assignments {
+		e := a.Occurrence.Event
+		resp.Assignments = append(resp.Assignments, &proto.RotationAssignment{
+			RecurrenceId: formatEventTime(a.Occurrence.RecurrenceID, e.AllDay, loc),
+			StartDate:    formatEventTime(e.StartDate, e.AllDay, loc),
+			EndDate:      formatEventTime(e.EndDate, e.AllDay, loc),
+			UserId:       a.UserID,
+		})
+	}
+	return resp
+}
+
+// EventFilterFromGetWorkload converts a workload request to the filter whose
+// occurrences rotation.Summarize totals. Its window must be bounded.
+func EventFilterFromGetWorkload(req *proto.GetWorkloadRequest) (repository.EventFilter, error) {
+	if req.GetHouseholdId() == "" {
+		return repository.EventFilter{}, fmt.Errorf("%w: household_id is required", ErrInvalidArgument)
+	}
+	f, err := eventFilter(eventQuery{
+		householdID: req.GetHouseholdId(), entityType: req.GetEntityType(),
+		start: req.GetStart(), end: req.GetEnd(), timeZone: req.GetTimeZone(),
+	})
+	if err == nil && (f.From.IsZero() || f.To.IsZero()) {
+		err = repository.ErrUnboundedWindow
+	}
+	return f, err
+}
+
+func WorkloadsToProto(workloads []rotation.Workload) *proto.WorkloadResponse {
+	resp := &proto.WorkloadResponse{}
+	for _, w := range workloads {
+		resp.Workloads = append(resp.Workloads, &proto.MemberWorkload{
+			UserId:          w.UserID,
+			Count:           int32(w.Count),
+			DurationSeconds: int64(w.Duration / time.Second),
+		})
+	}
+	return resp
+}
+
+func AssignRotationError(err error) *proto.AssignRotationResponse {
+	return &proto.AssignRotationResponse{ErrorMessage: Error(err)}
+}
+
+func WorkloadError(err error) *proto.WorkloadResponse {
+	return &proto.WorkloadResponse{ErrorMessage: Error(err)}
+}
+
+// window parses a bounded period [start, end) read in loc.
+func window(start, end string, loc *time.Location) (rotation.Window, error) {
+	w := rotation.Window{Location: loc}
+	var err error
+	if w.From, err = utils.ParseTime(start, loc); err != nil {
+		return w, fmt.Errorf("start: %w", err)
+	}
+	if w.To, err = utils.ParseTime(end, loc); err != nil {
+		return w, fmt.Errorf("end: %w", err)
+	}
+	if w.From.IsZero() || w.To.IsZero() {
+		return w, repository.ErrUnboundedWindow
+	}
+	if !w.From.Before(w.To) {
+		return w, fmt.Errorf("%w: start must be before end", ErrInvalidArgument)
+	}
+	return w, nil
+}
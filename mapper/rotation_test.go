@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/rotation"
+	"github.com/hmlylab/common/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationFromRequest(t *testing.T) {
+	zone := "Asia/Tokyo"
+	req := &proto.AssignRotationRequest{
+		EventId: "e1", Strategy: proto.RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD,
+		Start: "2025-05-05", End: "2025-05-12", TimeZone: &zone,
+		Members: []*proto.RotationMember{
+			{UserId: "u1", ExcludedWeekdays: []int32{0, 6}},
+			{UserId: "u2", Unavailable: []*proto.Unavailability{{Start: "2025-05-05", End: "2025-05-07"}}},
+		},
+	}
+	r, w, err := RotationFromRequest(req)
+	if errors.Is(err, utils.ErrInvalidTime) {
+		t.Skip("no tzdata:", err)
+	}
+	require.NoError(t, err)
+	assert.Equal(t, rotation.LeastLoad, r.Strategy)
+	assert.Equal(t, []time.Weekday{time.Sunday, time.Saturday}, r.Members[0].ExcludedWeekdays)
+	assert.Equal(t, time.Date(2025, 5, 4, 15, 0, 0, 0, time.UTC), w.From, "the window is read in the zone")
+	assert.Equal(t, time.Date(2025, 5, 6, 15, 0, 0, 0, time.UTC), r.Members[1].Unavailable[0].End)
+
+	for name, req := range map[string]*proto.AssignRotationRequest{
+		"no event":    {Start: "2025-05-05", End: "2025-05-12", Members: req.Members},
+		"open window": {EventId: "e1", Start: "2025-05-05", Members: req.Members},
+		"no members":  {EventId: "e1", Start: "2025-05-05", End: "2025-05-12"},
+		"bad weekday": {EventId: "e1", Start: "2025-05-05", End: "2025-05-12", Members: []*proto.RotationMember{{UserId: "u1", ExcludedWeekdays: []int32{7}}}},
+		"strategy":    {EventId: "e1", Start: "2025-05-05", End: "2025-05-12", Members: req.Members, Strategy: 9},
+	} {
+		_, _, err := RotationFromRequest(req)
+		assert.Equal(t, int32(400), Error(err).GetCode(), name)
+	}
+}
+
+func TestAssignmentsToProto(t *testing.T) {
+	start := time.Date(2025, 5, 5, 17, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC)
+	resp := AssignmentsToProto([]rotation.Assignment{
+		{Occurrence: domain.Occurrence{Event: domain.Event{StartDate: start, EndDate: start.Add(time.Hour)}, RecurrenceID: start}, UserID: "u1"},
+		{Occurrence: domain.Occurrence{Event: domain.Event{AllDay: true, StartDate: day, EndDate: day.AddDate(0, 0, 1)}, RecurrenceID: day}},
+	}, true, nil)
+
+	assert.True(t, resp.GetDryRun())
+	require.Len(t, resp.GetAssignments(), 2)
+	assert.Equal(t, "u1", resp.GetAssignments()[0].GetUserId())
+	assert.Equal(t, "2025-05-05T17:00:00Z", resp.GetAssignments()[0].GetRecurrenceId())
+	assert.Equal(t, "2025-05-06", resp.GetAssignments()[1].GetStartDate())
+	assert.Empty(t, resp.GetAssignments()[1].GetUserId(), "nobody was available")
+}
+
+func TestWorkload(t *testing.T) {
+	f, err := EventFilterFromGetWorkload(&proto.GetWorkloadRequest{HouseholdId: "h1", Start: "2025-05-01", End: "2025-06-01"})
+	require.NoError(t, err)
+	assert.Equal(t, "h1", f.HouseholdID)
+	assert.Equal(t, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), f.To)
+
+	_, err = EventFilterFromGetWorkload(&proto.GetWorkloadRequest{HouseholdId: "h1", Start: "2025-05-01"})
+	assert.ErrorIs(t, err, repository.ErrUnboundedWindow)
+	_, err = EventFilterFromGetWorkload(&proto.GetWorkloadRequest{Start: "2025-05-01", End: "2025-06-01"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+
+	resp := WorkloadsToProto([]rotation.Workload{{UserID: "u1", Count: 3, Duration: 90 * time.Minute}})
+	require.Len(t, resp.GetWorkloads(), 1)
+	assert.Equal(t, int32(3), resp.GetWorkloads()[0].GetCount())
+	assert.Equal(t, int64(5400), resp.GetWorkloads()[0].GetDurationSeconds())
+	assert.Equal(t, int32(400), WorkloadError(rotation.ErrNoMembers).GetErrorMessage().GetCode())
+}
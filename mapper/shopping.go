@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/hmlylab/common/utils"
+)
+
+func ShoppingListToProto(l domain.ShoppingList) *proto.ShoppingListResponse {
+	resp := &proto.ShoppingListResponse{
+		Id:          l.ID,
+		HouseholdId: l.HouseholdID,
+		Name:        l.Name,
+		Start:       formatDate(l.StartDate),
+		End:         formatDate(l.EndDate),
+		CreatedAt:   formatTime(l.CreatedAt),
+		UpdatedAt:   formatTime(l.UpdatedAt),
+	}
+	for _, item := range l.Items {
+		resp.Items = append(resp.Items, &proto.ShoppingItem{
+			Id:        item.ID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Unit:      item.Unit,
+			Note:      item.Note,
+			Recipes:   item.Recipes,
+			Manual:    item.Manual,
+			Checked:   item.Checked,
+			CheckedBy: item.CheckedBy,
+		})
+	}
+	return resp
+}
+
+func ShoppingListsToProto(lists []domain.ShoppingList) *proto.ShoppingListsResponse {
+	resp := &proto.ShoppingListsResponse{Lists: make([]*proto.ShoppingListResponse, len(lists))}
+	for i, l := range lists {
+		resp.Lists[i] = ShoppingListToProto(l)
+	}
+	return resp
+}
+
+// ShoppingListFromCreate converts a create request to an empty list for
+// shopping.Generate to fill.
+func ShoppingListFromCreate(req *proto.CreateShoppingListRequest) (domain.ShoppingList, error) {
+	l := domain.ShoppingList{HouseholdID: req.GetHouseholdId(), Name: req.GetName()}
+	if l.HouseholdID == "" {
+		return l, fmt.Errorf("%w: household_id is required", ErrInvalidArgument)
+	}
+	var err error
+	if l.StartDate, err = utils.ParseDate(req.GetStart()); err != nil {
+		return l, fmt.Errorf("start: %w", err)
+	}
+	if l.EndDate, err = utils.ParseDate(req.GetEnd()); err != nil {
+		return l, fmt.Errorf("end: %w", err)
+	}
+	if l.StartDate.IsZero() || l.EndDate.IsZero() || !l.StartDate.Before(l.EndDate) {
+		return l, fmt.Errorf("%w: start and end dates are required, start first", ErrInvalidArgument)
+	}
+	return l, nil
+}
+
+func ShoppingListFilterFromRequest(req *proto.GetShoppingListsRequest) repository.ShoppingListFilter {
+	return repository.ShoppingListFilter{
+		HouseholdID: req.GetHouseholdId(),
+		Offset:      int(req.GetOffset()),
+		Limit:       int(req.GetLimit()),
+	}
+}
+
+// ShoppingItemFromAdd converts a request to add an item, which is manual.
+func ShoppingItemFromAdd(req *proto.AddShoppingItemRequest) (domain.ShoppingItem, error) {
+	item := domain.ShoppingItem{Name: req.GetName(), Quantity: req.GetQuantity(), Unit: req.GetUnit(), Note: req.GetNote(), Manual: true}
+	if req.GetListId() == "" || item.Name == "" {
+		return item, fmt.Errorf("%w: list_id and name are required", ErrInvalidArgument)
+	}
+	if item.Quantity < 0 {
+		return item, fmt.Errorf("%w: quantity cannot be negative", ErrInvalidArgument)
+	}
+	return item, nil
+}
+
+func ShoppingListError(err error) *proto.ShoppingListResponse {
+	return &proto.ShoppingListResponse{ErrorMessage: Error(err)}
+}
+
+func ShoppingListsError(err error) *proto.ShoppingListsResponse {
+	return &proto.ShoppingListsResponse{ErrorMessage: Error(err)}
+}
@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/proto"
+	"github.com/hmlylab/common/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShoppingListToProto(t *testing.T) {
+	l := domain.ShoppingList{
+		BaseModel: base, HouseholdID: "h1", Name: "Week 19",
+		StartDate: time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC),
+		Items: []domain.ShoppingItem{
+			{BaseModel: base, Name: "flour", Quantity: 1.2, Unit: "kg", Recipes: domain.StringList{"Bread"}, Checked: true, CheckedBy: "u1"},
+			{Name: "coffee", Manual: true},
+		},
+	}
+	resp := ShoppingListToProto(l)
+	assert.Equal(t, "2025-05-05", resp.GetStart())
+	assert.Equal(t, "2025-05-12", resp.GetEnd())
+	require.Len(t, resp.GetItems(), 2)
+	assert.Equal(t, []string{"Bread"}, resp.GetItems()[0].GetRecipes())
+	assert.Equal(t, "u1", resp.GetItems()[0].GetCheckedBy())
+	assert.True(t, resp.GetItems()[1].GetManual())
+	assert.Len(t, ShoppingListsToProto([]domain.ShoppingList{l}).GetLists(), 1)
+}
+
+func TestShoppingListRequests(t *testing.T) {
+	l, err := ShoppingListFromCreate(&proto.CreateShoppingListRequest{HouseholdId: "h1", Name: "Week 19", Start: "2025-05-05", End: "2025-05-12"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC), l.EndDate)
+
+	for name, req := range map[string]*proto.CreateShoppingListRequest{
+		"no household": {Start: "2025-05-05", End: "2025-05-12"},
+		"no end":       {HouseholdId: "h1", Start: "2025-05-05"},
+		"backwards":    {HouseholdId: "h1", Start: "2025-05-12", End: "2025-05-05"},
+		"bad date":     {HouseholdId: "h1", Start: "Monday", End: "2025-05-12"},
+	} {
+		_, err := ShoppingListFromCreate(req)
+		assert.Equal(t, int32(400), ShoppingListError(err).GetErrorMessage().GetCode(), name)
+	}
+
+	limit := int32(3)
+	assert.Equal(t, repository.ShoppingListFilter{HouseholdID: "h1", Limit: 3}, ShoppingListFilterFromRequest(&proto.GetShoppingListsRequest{HouseholdId: "h1", Limit: &limit}))
+
+	item, err := ShoppingItemFromAdd(&proto.AddShoppingItemRequest{ListId: "l1", Name: "coffee", Quantity: 1, Unit: "kg"})
+	require.NoError(t, err)
+	assert.True(t, item.Manual)
+	_, err = ShoppingItemFromAdd(&proto.AddShoppingItemRequest{ListId: "l1"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Equal(t, int32(400), ShoppingListsError(domain.ErrInvalidShoppingList).GetErrorMessage().GetCode())
+}
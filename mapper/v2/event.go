@@ -0,0 +1,113 @@
+package mapperv2
+
+import (
+	"fmt"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func EventToProto(e domain.Event) *hmlyv2.EventResponse {
+	resp := &hmlyv2.EventResponse{
+		Id:          e.ID,
+		Name:        e.Name,
+		EntityId:    e.EntityID,
+		EntityType:  e.EntityType,
+		HouseholdId: e.HouseholdID,
+		StartDate:   Timestamp(e.StartDate),
+		EndDate:     Timestamp(e.EndDate),
+		AssignedTo:  e.AssignedTo,
+		AllDay:      e.AllDay,
+		Rrule:       e.RRule,
+		Exdates:     timestamps(e.ExDates),
+		SeriesId:    e.SeriesID,
+		CreatedAt:   Timestamp(e.CreatedAt),
+		UpdatedAt:   Timestamp(e.UpdatedAt),
+	}
+	if !e.StartDate.IsZero() && !e.EndDate.IsZero() {
+		resp.Duration = durationpb.New(e.EndDate.Sub(e.StartDate))
+	}
+	if e.RecurrenceID != nil {
+		resp.RecurrenceId = timestamppb.New(*e.RecurrenceID)
+	}
+	return resp
+}
+
+// OccurrenceToProto converts an occurrence, which carries the ID of the
+// event it came from and the series it belongs to.
+func OccurrenceToProto(o domain.Occurrence) *hmlyv2.EventResponse {
+	resp := EventToProto(o.Event)
+	if resp.SeriesId == "" && o.Event.IsRecurring() {
+		resp.SeriesId = o.Event.ID
+	}
+	if !o.RecurrenceID.IsZero() {
+		resp.RecurrenceId = timestamppb.New(o.RecurrenceID)
+	}
+	return resp
+}
+
+// EventFromCreate converts a create request. Without an end date the event
+// lasts for duration, or else a day if it is all-day and no time otherwise.
+func EventFromCreate(req *hmlyv2.CreateEventRequest) (domain.Event, error) {
+	e := domain.Event{
+		Name:        req.GetName(),
+		EntityID:    req.GetEntityId(),
+		EntityType:  req.GetEntityType(),
+		HouseholdID: req.GetHouseholdId(),
+		AssignedTo:  req.GetAssignedTo(),
+		AllDay:      req.GetAllDay(),
+		RRule:       req.GetRrule(),
+	}
+	if err := setEventTimes(&e, req.GetStartDate(), req.GetEndDate(), req.GetDuration(), req.GetExdates()); err != nil {
+		return e, err
+	}
+	return e, e.Validate()
+}
+
+// EventFromUpdate converts an update request. The recurrence ID and scope are
+// left to the caller, which decides how the update applies to a series.
+func EventFromUpdate(req *hmlyv2.UpdateEventRequest) (domain.Event, error) {
+	e := domain.Event{
+		BaseModel:   domain.BaseModel{ID: req.GetId()},
+		Name:        req.GetName(),
+		EntityID:    req.GetEntityId(),
+		EntityType:  req.GetEntityType(),
+		HouseholdID: req.GetHouseholdId(),
+		AssignedTo:  req.GetAssignedTo(),
+		AllDay:      req.GetAllDay(),
+		RRule:       req.GetRrule(),
+	}
+	if err := setEventTimes(&e, req.GetStartDate(), req.GetEndDate(), req.GetDuration(), req.GetExdates()); err != nil {
+		return e, err
+	}
+	return e, e.Validate()
+}
+
+func setEventTimes(e *domain.Event, start, end *timestamppb.Timestamp, d *durationpb.Duration, exdates []*timestamppb.Timestamp) error {
+	var err error
+	if e.StartDate, err = Time(start); err != nil {
+		return fmt.Errorf("start_date: %w", err)
+	}
+	if e.EndDate, err = Time(end); err != nil {
+		return fmt.Errorf("end_date: %w", err)
+	}
+	if e.EndDate.IsZero() && !e.StartDate.IsZero() {
+		switch {
+		case d != nil:
+			if err := d.CheckValid(); err != nil {
+				return fmt.Errorf("%w: duration: %v", ErrInvalidArgument, err)
+			}
+			e.EndDate = e.StartDate.Add(d.AsDuration())
+		case e.AllDay:
+			e.EndDate = e.StartDate.AddDate(0, 0, 1)
+		default:
+			e.EndDate = e.StartDate
+		}
+	}
+	if e.ExDates, err = times(exdates); err != nil {
+		return fmt.Errorf("exdates: %w", err)
+	}
+	return nil
+}
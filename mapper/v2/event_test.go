@@ -0,0 +1,106 @@
+package mapperv2
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	start := time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+	event := domain.Event{
+		BaseModel:   domain.BaseModel{ID: "e1", CreatedAt: start.Add(-time.Hour), UpdatedAt: start.Add(-time.Minute)},
+		Name:        "Dinner",
+		EntityID:    "m1",
+		EntityType:  "meal",
+		HouseholdID: "h1",
+		StartDate:   start,
+		EndDate:     start.Add(90 * time.Minute),
+		AssignedTo:  "u1",
+		RRule:       "FREQ=WEEKLY",
+		ExDates:     domain.TimeList{start.AddDate(0, 0, 7)},
+	}
+
+	resp := EventToProto(event)
+	if resp.GetDuration().AsDuration() != 90*time.Minute {
+		t.Errorf("Duration = %v, want 1h30m", resp.GetDuration().AsDuration())
+	}
+	if resp.GetRecurrenceId() != nil || resp.GetCreatedAt().AsTime() != event.CreatedAt {
+		t.Errorf("Unexpected response %v", resp)
+	}
+
+	got, err := EventFromUpdate(&hmlyv2.UpdateEventRequest{
+		Id: resp.GetId(), Name: resp.GetName(), EntityId: resp.GetEntityId(), EntityType: resp.GetEntityType(),
+		HouseholdId: resp.GetHouseholdId(), StartDate: resp.GetStartDate(), EndDate: resp.GetEndDate(),
+		AssignedTo: resp.GetAssignedTo(), Rrule: resp.GetRrule(), Exdates: resp.GetExdates(),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	got.CreatedAt, got.UpdatedAt = event.CreatedAt, event.UpdatedAt
+	if got.ID != event.ID || got.Name != event.Name || !got.StartDate.Equal(event.StartDate) ||
+		!got.EndDate.Equal(event.EndDate) || got.RRule != event.RRule || !got.ExDates.Contains(event.ExDates[0]) {
+		t.Errorf("Round trip = %+v, want %+v", got, event)
+	}
+}
+
+func TestEventFromCreate_End(t *testing.T) {
+	start := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		req  *hmlyv2.CreateEventRequest
+		want time.Time
+	}{
+		{"end date", &hmlyv2.CreateEventRequest{StartDate: Timestamp(start), EndDate: Timestamp(start.Add(time.Hour)), Duration: durationpb.New(time.Minute)}, start.Add(time.Hour)},
+		{"duration", &hmlyv2.CreateEventRequest{StartDate: Timestamp(start), Duration: durationpb.New(45 * time.Minute)}, start.Add(45 * time.Minute)},
+		{"all day", &hmlyv2.CreateEventRequest{StartDate: Timestamp(start), AllDay: true}, start.AddDate(0, 0, 1)},
+		{"instant", &hmlyv2.CreateEventRequest{StartDate: Timestamp(start)}, start},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := EventFromCreate(tt.req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !e.EndDate.Equal(tt.want) {
+				t.Errorf("EndDate = %v, want %v", e.EndDate, tt.want)
+			}
+		})
+	}
+
+	_, err := EventFromCreate(&hmlyv2.CreateEventRequest{StartDate: &timestamppb.Timestamp{Seconds: -1 << 40}})
+	if !errors.Is(err, ErrInvalidTimestamp) || !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidTimestamp, got %v", err)
+	}
+
+	for name, req := range map[string]*hmlyv2.CreateEventRequest{
+		"no start":     {Name: "Dinner"},
+		"end first":    {StartDate: Timestamp(start), EndDate: Timestamp(start.Add(-time.Hour))},
+		"bad duration": {StartDate: Timestamp(start), Duration: &durationpb.Duration{Seconds: 1, Nanos: -1}},
+	} {
+		if _, err := EventFromCreate(req); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("%s: expected ErrInvalidArgument, got %v", name, err)
+		}
+	}
+	if _, err := EventFromUpdate(&hmlyv2.UpdateEventRequest{Id: "e1", StartDate: Timestamp(start), Rrule: "FREQ=HOURLY"}); !errors.Is(err, domain.ErrInvalidRRule) {
+		t.Errorf("Expected ErrInvalidRRule, got %v", err)
+	}
+}
+
+func TestOccurrenceToProto(t *testing.T) {
+	start := time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+	series := domain.Event{BaseModel: domain.BaseModel{ID: "s1"}, StartDate: start, EndDate: start.Add(time.Hour), RRule: "FREQ=DAILY"}
+	occurrences, err := series.Occurrences(start, start.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp := OccurrenceToProto(occurrences[1])
+	if resp.GetSeriesId() != "s1" || !resp.GetRecurrenceId().AsTime().Equal(start.AddDate(0, 0, 1)) {
+		t.Errorf("Unexpected occurrence %v", resp)
+	}
+}
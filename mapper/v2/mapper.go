@@ -0,0 +1,92 @@
+// Package mapperv2 converts between domain models and the v2 proto messages,
+// whose times are Timestamps and Durations. See mapper for the v1 messages.
+package mapperv2
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ErrInvalidArgument wraps the errors of requests that cannot be converted,
+// as in mapper.
+var ErrInvalidArgument = domain.ErrInvalidArgument
+
+var ErrInvalidTimestamp = fmt.Errorf("%w: invalid timestamp", ErrInvalidArgument)
+
+// Timestamp converts t, returning nil for the zero time.
+func Timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// Time converts ts to a UTC time, returning the zero time for nil.
+func Time(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", ErrInvalidTimestamp, err)
+	}
+	return ts.AsTime(), nil
+}
+
+func timestamps(ts []time.Time) []*timestamppb.Timestamp {
+	if len(ts) == 0 {
+		return nil
+	}
+	out := make([]*timestamppb.Timestamp, len(ts))
+	for i, t := range ts {
+		out[i] = timestamppb.New(t)
+	}
+	return out
+}
+
+func times(ts []*timestamppb.Timestamp) (domain.TimeList, error) {
+	if len(ts) == 0 {
+		return nil, nil
+	}
+	out := make(domain.TimeList, len(ts))
+	for i, t := range ts {
+		var err error
+		if out[i], err = Time(t); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func HouseholdToProto(h domain.Household) *hmlyv2.HouseholdResponse {
+	return &hmlyv2.HouseholdResponse{
+		Id:        h.ID,
+		Name:      h.Name,
+		TimeZone:  h.TimeZone,
+		CreatedAt: Timestamp(h.CreatedAt),
+		UpdatedAt: Timestamp(h.UpdatedAt),
+	}
+}
+
+func MemberToProto(m domain.Member) *hmlyv2.MemberResponse {
+	return &hmlyv2.MemberResponse{
+		Id:          m.ID,
+		HouseholdId: m.HouseholdID,
+		UserId:      m.UserID,
+		CreatedAt:   Timestamp(m.CreatedAt),
+		UpdatedAt:   Timestamp(m.UpdatedAt),
+	}
+}
+
+func MealToProto(m domain.Meal) *hmlyv2.MealResponse {
+	return &hmlyv2.MealResponse{
+		Id:          m.ID,
+		Name:        m.Name,
+		HouseholdId: m.HouseholdID,
+		CreatedAt:   Timestamp(m.CreatedAt),
+		UpdatedAt:   Timestamp(m.UpdatedAt),
+	}
+}
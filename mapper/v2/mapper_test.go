@@ -0,0 +1,44 @@
+package mapperv2
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var start = time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+
+func TestTime(t *testing.T) {
+	if Timestamp(time.Time{}) != nil {
+		t.Error("Expected nil for the zero time")
+	}
+	if got, err := Time(nil); err != nil || !got.IsZero() {
+		t.Errorf("Time(nil) = %v, %v", got, err)
+	}
+	tokyo := time.FixedZone("JST", 9*60*60)
+	in := time.Date(2025, 5, 5, 18, 30, 0, 0, tokyo)
+	got, err := Time(Timestamp(in))
+	if err != nil || !got.Equal(in) || got.Location() != time.UTC {
+		t.Errorf("Time(Timestamp(%v)) = %v, %v", in, got, err)
+	}
+	if _, err := Time(&timestamppb.Timestamp{Seconds: 1, Nanos: -1}); !errors.Is(err, ErrInvalidTimestamp) {
+		t.Errorf("Expected ErrInvalidTimestamp, got %v", err)
+	}
+}
+
+func TestHouseholdMemberMealToProto(t *testing.T) {
+	now := time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+	base := domain.BaseModel{ID: "id", CreatedAt: now, UpdatedAt: now}
+	if h := HouseholdToProto(domain.Household{BaseModel: base, Name: "Home", TimeZone: "Europe/London"}); h.GetTimeZone() != "Europe/London" || !h.GetCreatedAt().AsTime().Equal(now) {
+		t.Errorf("Unexpected household %v", h)
+	}
+	if m := MemberToProto(domain.Member{BaseModel: base, UserID: "u1", HouseholdID: "h1"}); m.GetUserId() != "u1" || !m.GetUpdatedAt().AsTime().Equal(now) {
+		t.Errorf("Unexpected member %v", m)
+	}
+	if m := MealToProto(domain.Meal{Name: "Soup", HouseholdID: "h1"}); m.GetName() != "Soup" || m.GetCreatedAt() != nil {
+		t.Errorf("Unexpected meal %v", m)
+	}
+}
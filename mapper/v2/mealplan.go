@@ -0,0 +1,61 @@
+package mapperv2
+
+import (
+	"fmt"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"github.com/hmlylab/common/utils"
+)
+
+var mealSlots = map[hmlyv2.MealSlot]domain.MealSlot{
+	hmlyv2.MealSlot_MEAL_SLOT_BREAKFAST: domain.Breakfast,
+	hmlyv2.MealSlot_MEAL_SLOT_LUNCH:     domain.Lunch,
+	hmlyv2.MealSlot_MEAL_SLOT_DINNER:    domain.Dinner,
+	hmlyv2.MealSlot_MEAL_SLOT_SNACK:     domain.Snack,
+}
+
+func MealPlanEntryToProto(e domain.MealPlanEntry) *hmlyv2.MealPlanEntryResponse {
+	resp := &hmlyv2.MealPlanEntryResponse{
+		Id:          e.ID,
+		HouseholdId: e.HouseholdID,
+		RecipeId:    e.RecipeID,
+		Servings:    int32(e.Servings),
+		Note:        e.Note,
+		CreatedAt:   Timestamp(e.CreatedAt),
+		UpdatedAt:   Timestamp(e.UpdatedAt),
+	}
+	for s, slot := range mealSlots {
+		if slot == e.Slot {
+			resp.Slot = s
+		}
+	}
+	if !e.Date.IsZero() {
+		resp.Date = e.Date.Format(utils.DateLayout)
+	}
+	if e.Recipe != nil {
+		resp.Recipe = RecipeToProto(*e.Recipe)
+	}
+	return resp
+}
+
+// MealPlanEntryFromCreate converts a create request, whose date is
+// YYYY-MM-DD.
+func MealPlanEntryFromCreate(req *hmlyv2.CreateMealPlanEntryRequest) (domain.MealPlanEntry, error) {
+	e := domain.MealPlanEntry{
+		HouseholdID: req.GetHouseholdId(),
+		RecipeID:    req.GetRecipeId(),
+		Servings:    int(req.GetServings()),
+		Note:        req.GetNote(),
+	}
+	slot, ok := mealSlots[req.GetSlot()]
+	if !ok {
+		return e, fmt.Errorf("%w %v", domain.ErrInvalidMealSlot, req.GetSlot())
+	}
+	e.Slot = slot
+	var err error
+	if e.Date, err = utils.ParseDate(req.GetDate()); err != nil {
+		return e, fmt.Errorf("date: %w", err)
+	}
+	return e, nil
+}
@@ -0,0 +1,35 @@
+package mapperv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+)
+
+func TestRecipeAndMealPlan(t *testing.T) {
+	r := RecipeFromCreate(&hmlyv2.CreateRecipeRequest{
+		HouseholdId: "h1", Name: "Pancakes", Servings: 4,
+		Ingredients: []*hmlyv2.Ingredient{{Name: "flour", Quantity: 200, Unit: "g"}, {Name: "milk", Quantity: 300, Unit: "ml"}},
+	})
+	if len(r.Ingredients) != 2 || r.Ingredients[1].Position != 1 || r.Ingredients[1].Unit != "ml" {
+		t.Errorf("Unexpected ingredients %+v", r.Ingredients)
+	}
+	if resp := RecipeToProto(r); len(resp.GetIngredients()) != 2 || resp.GetServings() != 4 {
+		t.Errorf("Unexpected recipe %v", resp)
+	}
+
+	e, err := MealPlanEntryFromCreate(&hmlyv2.CreateMealPlanEntryRequest{HouseholdId: "h1", Date: "2025-05-06", Slot: hmlyv2.MealSlot_MEAL_SLOT_DINNER, RecipeId: "r1"})
+	if err != nil {
+		t.Fatalf("MealPlanEntryFromCreate: %v", err)
+	}
+	e.Recipe = &r
+	resp := MealPlanEntryToProto(e)
+	if resp.GetDate() != "2025-05-06" || resp.GetSlot() != hmlyv2.MealSlot_MEAL_SLOT_DINNER || resp.GetRecipe().GetName() != "Pancakes" {
+		t.Errorf("Unexpected entry %v", resp)
+	}
+	if _, err := MealPlanEntryFromCreate(&hmlyv2.CreateMealPlanEntryRequest{Date: "2025-05-06"}); !errors.Is(err, domain.ErrInvalidMealSlot) {
+		t.Errorf("Expected ErrInvalidMealSlot, got %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package mapperv2
+
+import (
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+)
+
+func RecipeToProto(r domain.Recipe) *hmlyv2.RecipeResponse {
+	resp := &hmlyv2.RecipeResponse{
+		Id:          r.ID,
+		HouseholdId: r.HouseholdID,
+		Name:        r.Name,
+		Description: r.Description,
+		Servings:    int32(r.Servings),
+		Steps:       r.Steps,
+		Tags:        r.Tags,
+		CreatedAt:   Timestamp(r.CreatedAt),
+		UpdatedAt:   Timestamp(r.UpdatedAt),
+	}
+	for _, in := range r.Ingredients {
+		resp.Ingredients = append(resp.Ingredients, &hmlyv2.Ingredient{Name: in.Name, Quantity: in.Quantity, Unit: in.Unit, Note: in.Note})
+	}
+	return resp
+}
+
+func RecipeFromCreate(req *hmlyv2.CreateRecipeRequest) domain.Recipe {
+	r := domain.Recipe{
+		HouseholdID: req.GetHouseholdId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Servings:    int(req.GetServings()),
+		Steps:       req.GetSteps(),
+		Tags:        req.GetTags(),
+	}
+	for i, in := range req.GetIngredients() {
+		r.Ingredients = append(r.Ingredients, domain.Ingredient{
+			Position: i, Name: in.GetName(), Quantity: in.GetQuantity(), Unit: in.GetUnit(), Note: in.GetNote(),
+		})
+	}
+	return r
+}
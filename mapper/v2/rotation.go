@@ -0,0 +1,98 @@
+package mapperv2
+
+import (
+	"fmt"
+	"time"
+
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"github.com/hmlylab/common/rotation"
+	"github.com/hmlylab/common/utils"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var strategies = map[hmlyv2.RotationStrategy]rotation.Strategy{
+	hmlyv2.RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN: rotation.RoundRobin,
+	hmlyv2.RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD:  rotation.LeastLoad,
+}
+
+// RotationFromRequest converts a rotation request. For LeastLoad the caller
+// fills in the rotation's Load.
+func RotationFromRequest(req *hmlyv2.AssignRotationRequest) (rotation.Rotation, rotation.Window, error) {
+	loc, err := utils.LoadLocation(req.GetTimeZone())
+	if err != nil {
+		return rotation.Rotation{}, rotation.Window{}, err
+	}
+	w := rotation.Window{Location: loc}
+	if w.From, w.To, err = period(req.GetStart(), req.GetEnd()); err != nil {
+		return rotation.Rotation{}, w, err
+	}
+	strategy, ok := strategies[req.GetStrategy()]
+	if !ok {
+		return rotation.Rotation{}, w, fmt.Errorf("%w %v", rotation.ErrUnknownStrategy, req.GetStrategy())
+	}
+
+	r := rotation.Rotation{Strategy: strategy, After: req.GetAfter()}
+	for _, m := range req.GetMembers() {
+		member := rotation.Member{UserID: m.GetUserId()}
+		for _, u := range m.GetUnavailable() {
+			start, end, err := period(u.GetStart(), u.GetEnd())
+			if err != nil {
+				return r, w, fmt.Errorf("unavailable: %w", err)
+			}
+			member.Unavailable = append(member.Unavailable, rotation.Period{Start: start, End: end})
+		}
+		for _, d := range m.GetExcludedWeekdays() {
+			if d < 0 || d > 6 {
+				return r, w, fmt.Errorf("%w: excluded weekday %d is not between 0 and 6", ErrInvalidArgument, d)
+			}
+			member.ExcludedWeekdays = append(member.ExcludedWeekdays, time.Weekday(d))
+		}
+		r.Members = append(r.Members, member)
+	}
+	if len(r.Members) == 0 {
+		return r, w, rotation.ErrNoMembers
+	}
+	return r, w, nil
+}
+
+func AssignmentsToProto(assignments []rotation.Assignment, dryRun bool) *hmlyv2.AssignRotationResponse {
+	resp := &hmlyv2.AssignRotationResponse{DryRun: dryRun}
+	for _, a := range assignments {
+		resp.Assignments = append(resp.Assignments, &hmlyv2.RotationAssignment{
+			RecurrenceId: Timestamp(a.Occurrence.RecurrenceID),
+			StartDate:    Timestamp(a.Occurrence.Event.StartDate),
+			EndDate:      Timestamp(a.Occurrence.Event.EndDate),
+			UserId:       a.UserID,
+		})
+	}
+	return resp
+}
+
+func WorkloadsToProto(workloads []rotation.Workload) *hmlyv2.WorkloadResponse {
+	resp := &hmlyv2.WorkloadResponse{}
+	for _, w := range workloads {
+		resp.Workloads = append(resp.Workloads, &hmlyv2.MemberWorkload{
+			UserId:   w.UserID,
+			Count:    int32(w.Count),
+			Duration: durationpb.New(w.Duration),
+		})
+	}
+	return resp
+}
+
+// period converts the bounds of [start, end), both of which are required.
+func period(start, end *timestamppb.Timestamp) (time.Time, time.Time, error) {
+	from, err := Time(start)
+	if err != nil {
+		return from, time.Time{}, fmt.Errorf("start: %w", err)
+	}
+	to, err := Time(end)
+	if err != nil {
+		return from, to, fmt.Errorf("end: %w", err)
+	}
+	if from.IsZero() || to.IsZero() || !from.Before(to) {
+		return from, to, fmt.Errorf("%w: start and end must be set, start first", ErrInvalidTimestamp)
+	}
+	return from, to, nil
+}
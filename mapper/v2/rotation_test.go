@@ -0,0 +1,74 @@
+package mapperv2
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"github.com/hmlylab/common/rotation"
+)
+
+func TestRotationFromRequest(t *testing.T) {
+	start := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	after := "u2"
+	req := &hmlyv2.AssignRotationRequest{
+		EventId:  "e1",
+		Strategy: hmlyv2.RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD,
+		Start:    Timestamp(start),
+		End:      Timestamp(start.AddDate(0, 0, 7)),
+		After:    &after,
+		Members: []*hmlyv2.RotationMember{
+			{UserId: "u1", ExcludedWeekdays: []int32{0, 6}},
+			{UserId: "u2", Unavailable: []*hmlyv2.Unavailability{{Start: Timestamp(start), End: Timestamp(start.AddDate(0, 0, 2))}}},
+		},
+	}
+	r, w, err := RotationFromRequest(req)
+	if err != nil {
+		t.Fatalf("RotationFromRequest: %v", err)
+	}
+	if r.Strategy != rotation.LeastLoad || r.After != "u2" || len(r.Members) != 2 {
+		t.Errorf("Unexpected rotation %+v", r)
+	}
+	if got := r.Members[0].ExcludedWeekdays; len(got) != 2 || got[1] != time.Saturday {
+		t.Errorf("ExcludedWeekdays = %v", got)
+	}
+	if got := r.Members[1].Unavailable; len(got) != 1 || !got[0].End.Equal(start.AddDate(0, 0, 2)) {
+		t.Errorf("Unavailable = %v", got)
+	}
+	if !w.From.Equal(start) || w.Location != time.UTC {
+		t.Errorf("Unexpected window %+v", w)
+	}
+
+	req.End = nil
+	if _, _, err := RotationFromRequest(req); !errors.Is(err, ErrInvalidTimestamp) {
+		t.Errorf("Expected ErrInvalidTimestamp for an open window, got %v", err)
+	}
+	req.End = Timestamp(start.AddDate(0, 0, 7))
+	req.Members[0].ExcludedWeekdays = []int32{7}
+	if _, _, err := RotationFromRequest(req); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("Expected ErrInvalidArgument for weekday 7, got %v", err)
+	}
+	req.Members = nil
+	if _, _, err := RotationFromRequest(req); !errors.Is(err, rotation.ErrNoMembers) {
+		t.Errorf("Expected ErrNoMembers, got %v", err)
+	}
+}
+
+func TestAssignmentsAndWorkloadsToProto(t *testing.T) {
+	start := time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+	occ := domain.Occurrence{Event: domain.Event{StartDate: start, EndDate: start.Add(time.Hour)}, RecurrenceID: start}
+	resp := AssignmentsToProto([]rotation.Assignment{{Occurrence: occ, UserID: "u1"}}, true)
+	if !resp.GetDryRun() || len(resp.GetAssignments()) != 1 {
+		t.Fatalf("Unexpected response %v", resp)
+	}
+	if a := resp.GetAssignments()[0]; a.GetUserId() != "u1" || !a.GetRecurrenceId().AsTime().Equal(start) {
+		t.Errorf("Unexpected assignment %v", a)
+	}
+
+	workloads := WorkloadsToProto(rotation.Summarize([]domain.Occurrence{occ}, "u1"))
+	if got := workloads.GetWorkloads(); len(got) != 2 || got[0].GetUserId() != "" || got[0].GetDuration().AsDuration() != time.Hour {
+		t.Errorf("Unexpected workloads %v", got)
+	}
+}
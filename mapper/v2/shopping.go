@@ -0,0 +1,71 @@
+package mapperv2
+
+import (
+	"fmt"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+	"github.com/hmlylab/common/utils"
+)
+
+func ShoppingListToProto(l domain.ShoppingList) *hmlyv2.ShoppingListResponse {
+	resp := &hmlyv2.ShoppingListResponse{
+		Id:          l.ID,
+		HouseholdId: l.HouseholdID,
+		Name:        l.Name,
+		CreatedAt:   Timestamp(l.CreatedAt),
+		UpdatedAt:   Timestamp(l.UpdatedAt),
+	}
+	if !l.StartDate.IsZero() {
+		resp.Start = l.StartDate.Format(utils.DateLayout)
+	}
+	if !l.EndDate.IsZero() {
+		resp.End = l.EndDate.Format(utils.DateLayout)
+	}
+	for _, item := range l.Items {
+		resp.Items = append(resp.Items, &hmlyv2.ShoppingItem{
+			Id:        item.ID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			Unit:      item.Unit,
+			Note:      item.Note,
+			Recipes:   item.Recipes,
+			Manual:    item.Manual,
+			Checked:   item.Checked,
+			CheckedBy: item.CheckedBy,
+		})
+	}
+	return resp
+}
+
+// ShoppingListFromCreate converts a create request, whose dates are
+// YYYY-MM-DD, to an empty list for shopping.Generate to fill.
+func ShoppingListFromCreate(req *hmlyv2.CreateShoppingListRequest) (domain.ShoppingList, error) {
+	l := domain.ShoppingList{HouseholdID: req.GetHouseholdId(), Name: req.GetName()}
+	if l.HouseholdID == "" {
+		return l, fmt.Errorf("%w: household_id is required", ErrInvalidArgument)
+	}
+	var err error
+	if l.StartDate, err = utils.ParseDate(req.GetStart()); err != nil {
+		return l, fmt.Errorf("start: %w", err)
+	}
+	if l.EndDate, err = utils.ParseDate(req.GetEnd()); err != nil {
+		return l, fmt.Errorf("end: %w", err)
+	}
+	if l.StartDate.IsZero() || l.EndDate.IsZero() || !l.StartDate.Before(l.EndDate) {
+		return l, fmt.Errorf("%w: start and end dates are required, start first", ErrInvalidArgument)
+	}
+	return l, nil
+}
+
+// ShoppingItemFromAdd converts a request to add an item, which is manual.
+func ShoppingItemFromAdd(req *hmlyv2.AddShoppingItemRequest) (domain.ShoppingItem, error) {
+	item := domain.ShoppingItem{Name: req.GetName(), Quantity: req.GetQuantity(), Unit: req.GetUnit(), Note: req.GetNote(), Manual: true}
+	if req.GetListId() == "" || item.Name == "" {
+		return item, fmt.Errorf("%w: list_id and name are required", ErrInvalidArgument)
+	}
+	if item.Quantity < 0 {
+		return item, fmt.Errorf("%w: quantity cannot be negative", ErrInvalidArgument)
+	}
+	return item, nil
+}
@@ -0,0 +1,47 @@
+package mapperv2
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hmlylab/common/domain"
+	hmlyv2 "github.com/hmlylab/common/proto/v2"
+)
+
+func TestShoppingList(t *testing.T) {
+	l, err := ShoppingListFromCreate(&hmlyv2.CreateShoppingListRequest{HouseholdId: "h1", Name: "Week 19", Start: "2025-05-05", End: "2025-05-12"})
+	if err != nil {
+		t.Fatalf("ShoppingListFromCreate: %v", err)
+	}
+	coffee, err := ShoppingItemFromAdd(&hmlyv2.AddShoppingItemRequest{ListId: "l1", Name: "coffee"})
+	if err != nil {
+		t.Fatalf("ShoppingItemFromAdd: %v", err)
+	}
+	l.Items = []domain.ShoppingItem{{Name: "flour", Quantity: 1.2, Unit: "kg"}, coffee}
+	resp := ShoppingListToProto(l)
+	if resp.GetStart() != "2025-05-05" || resp.GetEnd() != "2025-05-12" || len(resp.GetItems()) != 2 || !resp.GetItems()[1].GetManual() {
+		t.Errorf("Unexpected list %v", resp)
+	}
+	if _, err := ShoppingListFromCreate(&hmlyv2.CreateShoppingListRequest{HouseholdId: "h1", Start: "soon"}); err == nil {
+		t.Error("Expected an error for a bad date")
+	}
+
+	for name, req := range map[string]*hmlyv2.CreateShoppingListRequest{
+		"no household": {Start: "2025-05-05", End: "2025-05-12"},
+		"no end":       {HouseholdId: "h1", Start: "2025-05-05"},
+		"end first":    {HouseholdId: "h1", Start: "2025-05-12", End: "2025-05-05"},
+	} {
+		if _, err := ShoppingListFromCreate(req); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("%s: expected ErrInvalidArgument, got %v", name, err)
+		}
+	}
+	for name, req := range map[string]*hmlyv2.AddShoppingItemRequest{
+		"no list":           {Name: "tea"},
+		"no name":           {ListId: "l1"},
+		"negative quantity": {ListId: "l1", Name: "tea", Quantity: -1},
+	} {
+		if _, err := ShoppingItemFromAdd(req); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("%s: expected ErrInvalidArgument, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package migrate
+
+import "time"
+
+// The tables as migration 1 created them. Later migrations change the domain
+// models, so the baseline keeps its own copies; never edit them. The base
+// columns are spelled out because GORM skips unexported embedded structs.
+type (
+	baselineHousehold struct {
+		ID        string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		DeletedAt time.Time
+		Name      string
+	}
+
+	baselineMember struct {
+		ID          string
+		CreatedAt   time.Time
+		UpdatedAt   time.Time
+		DeletedAt   time.Time
+		UserID      string
+		HouseholdID string
+	}
+
+	baselineMeal struct {
+		ID          string
+		CreatedAt   time.Time
+		UpdatedAt   time.Time
+		DeletedAt   time.Time
+		Name        string
+		HouseholdID string
+	}
+
+	baselineEvent struct {
+		ID         string
+		CreatedAt  time.Time
+		UpdatedAt  time.Time
+		DeletedAt  time.Time
+		Name       string
+		EntityID   string
+		EntityType string
+		StartDate  time.Time `gorm:"autoUpdateTime:false"`
+		EndDate    time.Time `gorm:"autoUpdateTime:false"`
+		AssignedTo string
+	}
+
+	baselineUser struct {
+		ID        string
+		CreatedAt time.Time
+		UpdatedAt time.Time
+		DeletedAt time.Time
+		Name      string
+		Email     string
+		Password  string
+	}
+)
+
+func (baselineHousehold) TableName() string { return "households" }
+func (baselineMember) TableName() string    { return "members" }
+func (baselineMeal) TableName() string      { return "meals" }
+func (baselineEvent) TableName() string     { return "events" }
+func (baselineUser) TableName() string      { return "users" }
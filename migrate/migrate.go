@@ -0,0 +1,525 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	ErrLocked = errors.New("migrate: schema is locked by another process")
+)
+
+// advisoryLockKey identifies the migration lock in pg_advisory_lock.
+const advisoryLockKey = 7283943
+
+// The bookkeeping tables are created with IF NOT EXISTS rather than
+// AutoMigrate so that services booting at the same time don't race on DDL.
+const (
+	createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	name TEXT NOT NULL,
+	applied_at TIMESTAMP NOT NULL
+)`
+	createLockTable = `CREATE TABLE IF NOT EXISTS schema_migrations_lock (
+	id INTEGER PRIMARY KEY,
+	locked_at TIMESTAMP NOT NULL,
+	locked_by TEXT NOT NULL DEFAULT ''
+)`
+)
+
+// Migration is a single versioned, reversible schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// Status reports whether a known migration has been applied.
+type Status struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type schemaMigrationLock struct {
+	ID       int `gorm:"primaryKey;autoIncrement:false"`
+	LockedAt time.Time
+	// LockedBy is the host and process ID of the holder.
+	LockedBy string
+}
+
+func (schemaMigrationLock) TableName() string {
+	return "schema_migrations_lock"
+}
+
+type Migrator struct {
+	db          *gorm.DB
+	migrations  []Migration
+	LockTimeout time.Duration
+	// LockTTL is how old a lock row may get before another process takes it
+	// over, e.g. after its holder crashed. It must be longer than the slowest
+	// migration; zero never takes a lock over. Postgres releases its lock
+	// with the session and ignores it.
+	LockTTL      time.Duration
+	pollInterval time.Duration
+}
+
+func NewMigrator(db *gorm.DB, migrations ...Migration) *Migrator {
+	sorted := append([]Migration(nil), migrations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+	return &Migrator{
+		db:           db,
+		migrations:   sorted,
+		LockTimeout:  time.Minute,
+		LockTTL:      30 * time.Minute,
+		pollInterval: 100 * time.Millisecond,
+	}
+}
+
+func (m *Migrator) validate() error {
+	for i, mig := range m.migrations {
+		if mig.Version <= 0 {
+			return fmt.Errorf("migrate: migration %q has invalid version %d", mig.Name, mig.Version)
+		}
+		if mig.Up == nil {
+			return fmt.Errorf("migrate: migration %d has no up step", mig.Version)
+		}
+		if i > 0 && m.migrations[i-1].Version == mig.Version {
+			return fmt.Errorf("migrate: duplicate migration version %d", mig.Version)
+		}
+	}
+	return nil
+}
+
+// Up applies every pending migration in version order and returns how many ran.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if _, ok := done[mig.Version]; ok {
+				continue
+			}
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := mig.Up(tx); err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{
+					Version:   mig.Version,
+					Name:      mig.Name,
+					AppliedAt: time.Now().UTC(),
+				}).Error
+			}); err != nil {
+				logger.Default().Error("Failed to apply migration", "version", mig.Version, "name", mig.Name, "error", err)
+				return fmt.Errorf("migrate: up %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			logger.Default().Info("Applied migration", "version", mig.Version, "name", mig.Name)
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the most recently applied migrations, newest first.
+func (m *Migrator) Down(ctx context.Context, steps int) (int, error) {
+	reverted := 0
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(m.migrations) - 1; i >= 0 && reverted < steps; i-- {
+			mig := m.migrations[i]
+			if _, ok := done[mig.Version]; !ok {
+				continue
+			}
+			if mig.Down == nil {
+				return fmt.Errorf("migrate: migration %d_%s is irreversible", mig.Version, mig.Name)
+			}
+			if err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := mig.Down(tx); err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{}, "version = ?", mig.Version).Error
+			}); err != nil {
+				logger.Default().Error("Failed to revert migration", "version", mig.Version, "name", mig.Name, "error", err)
+				return fmt.Errorf("migrate: down %d_%s: %w", mig.Version, mig.Name, err)
+			}
+			logger.Default().Info("Reverted migration", "version", mig.Version, "name", mig.Name)
+			reverted++
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists every known migration alongside its applied state.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	conn := m.db.WithContext(ctx)
+	if !conn.Migrator().HasTable(&schemaMigration{}) {
+		return m.statuses(nil), nil
+	}
+	done, err := appliedVersions(conn)
+	if err != nil {
+		return nil, err
+	}
+	return m.statuses(done), nil
+}
+
+func (m *Migrator) statuses(done map[int64]schemaMigration) []Status {
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		s := Status{Version: mig.Version, Name: mig.Name}
+		if row, ok := done[mig.Version]; ok {
+			s.Applied = true
+			s.AppliedAt = row.AppliedAt
+		}
+		statuses = append(statuses, s)
+	}
+	return statuses
+}
+
+func appliedVersions(conn *gorm.DB) (map[int64]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := conn.Order("version").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	done := make(map[int64]schemaMigration, len(rows))
+	for _, row := range rows {
+		done[row.Version] = row
+	}
+	return done, nil
+}
+
+// withLock pins a single connection, takes the migration lock on it and runs fn.
+// Postgres uses a session advisory lock; other dialects fall back to a lock row.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		// Connection hands back a shared statement; start a fresh session on
+		// the pinned conn so chained calls below don't accumulate clauses.
+		conn = conn.Session(&gorm.Session{})
+		unlock, err := m.lock(ctx, conn)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+		if err := conn.Exec(createMigrationsTable).Error; err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+func (m *Migrator) lock(ctx context.Context, conn *gorm.DB) (func(), error) {
+	if conn.Dialector.Name() == "postgres" {
+		if err := m.advisoryLock(ctx, conn); err != nil {
+			return nil, err
+		}
+		return func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", advisoryLockKey).Error; err != nil {
+				logger.Default().Error("Failed to release migration lock", "error", err)
+			}
+		}, nil
+	}
+
+	if err := conn.Exec(createLockTable).Error; err != nil {
+		return nil, err
+	}
+	// Contended attempts fail on the primary key; keep them out of the GORM log.
+	quiet := conn.Session(&gorm.Session{Logger: conn.Logger.LogMode(gormlogger.Silent)})
+	deadline := time.Now().Add(m.LockTimeout)
+	for {
+		err := quiet.Create(&schemaMigrationLock{ID: 1, LockedAt: time.Now().UTC(), LockedBy: holder()}).Error
+		if err == nil {
+			break
+		}
+		var held schemaMigrationLock
+		found := quiet.Take(&held, "id = ?", 1).Error == nil
+		age := time.Since(held.LockedAt)
+		if found && m.LockTTL > 0 && age > m.LockTTL {
+			logger.Default().Warn("Taking over stale migration lock", "locked_by", held.LockedBy, "locked_at", held.LockedAt, "age", age.Round(time.Second))
+			// Only the row that was read is removed, so two processes taking
+			// over at once cannot both win.
+			if err := conn.Where("id = ? AND locked_at = ?", 1, held.LockedAt).Delete(&schemaMigrationLock{}).Error; err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if time.Now().After(deadline) {
+			if !found {
+				return nil, ErrLocked
+			}
+			return nil, fmt.Errorf("%w: held by %q since %s (%s ago)", ErrLocked, held.LockedBy, held.LockedAt.Format(time.RFC3339), age.Round(time.Second))
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(m.pollInterval):
+		}
+	}
+	return func() {
+		if err := conn.Delete(&schemaMigrationLock{}, "id = ?", 1).Error; err != nil {
+			logger.Default().Error("Failed to release migration lock", "error", err)
+		}
+	}, nil
+}
+
+// advisoryLock polls pg_try_advisory_lock so that LockTimeout applies on
+// Postgres too.
+func (m *Migrator) advisoryLock(ctx context.Context, conn *gorm.DB) error {
+	deadline := time.Now().Add(m.LockTimeout)
+	for {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", advisoryLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if locked {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			var pid int
+			if conn.Raw("SELECT pid FROM pg_locks WHERE locktype = 'advisory' AND classid = 0 AND objid = ? AND granted", advisoryLockKey).Scan(&pid).Error != nil || pid == 0 {
+				return ErrLocked
+			}
+			return fmt.Errorf("%w: held by backend pid %d", ErrLocked, pid)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.pollInterval):
+		}
+	}
+}
+
+// Unlock removes the lock row left by a process that died while migrating.
+// Postgres releases its lock when the session ends, so there is nothing to
+// remove there.
+func (m *Migrator) Unlock(ctx context.Context) error {
+	db := m.db.WithContext(ctx)
+	if db.Dialector.Name() == "postgres" || !db.Migrator().HasTable(&schemaMigrationLock{}) {
+		return nil
+	}
+	return db.Delete(&schemaMigrationLock{}, "id = ?", 1).Error
+}
+
+func holder() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s:%d", host, os.Getpid())
+}
+
+// LoadFS reads SQL migrations named <version>_<name>.up.sql and
+// <version>_<name>.down.sql from dir, e.g. an embed.FS.
+func LoadFS(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".sql") {
+			continue
+		}
+		base := strings.TrimSuffix(entry.Name(), ".sql")
+		direction := path.Ext(base)
+		if direction != ".up" && direction != ".down" {
+			return nil, fmt.Errorf("migrate: %s must end in .up.sql or .down.sql", entry.Name())
+		}
+		base = strings.TrimSuffix(base, direction)
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("migrate: %s must be named <version>_<name>", entry.Name())
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("migrate: %s has invalid version: %w", entry.Name(), err)
+		}
+		body, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: name}
+			byVersion[version] = mig
+		}
+		if direction == ".up" {
+			mig.Up = ExecSQL(string(body))
+		} else {
+			mig.Down = ExecSQL(string(body))
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == nil {
+			return nil, fmt.Errorf("migrate: migration %d_%s has no up file", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// ExecSQL returns a migration step that executes the given statements.
+func ExecSQL(statements string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		return tx.Exec(statements).Error
+	}
+}
+
+// AutoMigrate returns a migration step that creates or alters tables for models.
+func AutoMigrate(models ...interface{}) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		return tx.AutoMigrate(models...)
+	}
+}
+
+// AddColumns returns a migration step that adds columns to model's table as
+// model's fields define them. Columns that already exist are skipped, since
+// the first migration creates tables from the current models.
+func AddColumns(model interface{}, columns ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, column := range columns {
+			if tx.Migrator().HasColumn(model, column) {
+				continue
+			}
+			if err := tx.Migrator().AddColumn(model, column); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// CreateIndexes returns a migration step that creates model's named indexes,
+// skipping any that already exist.
+func CreateIndexes(model interface{}, names ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, name := range names {
+			if tx.Migrator().HasIndex(model, name) {
+				continue
+			}
+			if err := tx.Migrator().CreateIndex(model, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// DropTables returns a migration step that drops the tables for models.
+func DropTables(models ...interface{}) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(models...)
+	}
+}
+
+// DropColumns returns a migration step that drops columns from model's table.
+// SQLite drops a column by rebuilding the table, which loses its indexes, so
+// model's indexes that existed before and do not use a dropped column are
+// created again.
+func DropColumns(model interface{}, columns ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		stmt := &gorm.Statement{DB: tx}
+		if err := stmt.Parse(model); err != nil {
+			return err
+		}
+		dropped := make(map[string]bool, len(columns))
+		for _, column := range columns {
+			dropped[column] = true
+		}
+		var keep []string
+		for _, idx := range stmt.Schema.ParseIndexes() {
+			if tx.Migrator().HasIndex(model, idx.Name) && !usesAny(idx, dropped) {
+				keep = append(keep, idx.Name)
+			}
+		}
+
+		for _, column := range columns {
+			if err := tx.Migrator().DropColumn(model, column); err != nil {
+				return err
+			}
+		}
+		for _, name := range keep {
+			if tx.Migrator().HasIndex(model, name) {
+				continue
+			}
+			if err := tx.Migrator().CreateIndex(model, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func usesAny(idx *schema.Index, columns map[string]bool) bool {
+	for _, f := range idx.Fields {
+		if columns[f.DBName] {
+			return true
+		}
+	}
+	return false
+}
+
+// DropIndexes returns a migration step that drops indexes from model's table.
+func DropIndexes(model interface{}, names ...string) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, name := range names {
+			if err := tx.Migrator().DropIndex(model, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Steps returns a migration step that runs steps in order.
+func Steps(steps ...func(tx *gorm.DB) error) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, step := range steps {
+			if err := step(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
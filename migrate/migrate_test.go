@@ -0,0 +1,343 @@
+package migrate
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "migrate.db")), &gorm.Config{})
+	assert.NoError(t, err)
+	return db
+}
+
+func testMigrations() []Migration {
+	return []Migration{
+		{
+			Version: 2,
+			Name:    "add_widgets_color",
+			Up:      ExecSQL("ALTER TABLE widgets ADD COLUMN color TEXT"),
+			Down:    ExecSQL("ALTER TABLE widgets DROP COLUMN color"),
+		},
+		{
+			Version: 1,
+			Name:    "create_widgets",
+			Up:      ExecSQL("CREATE TABLE widgets (id TEXT PRIMARY KEY)"),
+			Down:    ExecSQL("DROP TABLE widgets"),
+		},
+	}
+}
+
+func TestMigrator_UpDownStatus(t *testing.T) {
+	db := setupTestDB(t)
+	m := NewMigrator(db, testMigrations()...)
+	ctx := context.Background()
+
+	statuses, err := m.Status(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, int64(1), statuses[0].Version)
+	assert.False(t, statuses[0].Applied)
+
+	n, err := m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.True(t, db.Migrator().HasColumn("widgets", "color"))
+
+	n, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n, "Up should be idempotent")
+
+	statuses, err = m.Status(ctx)
+	assert.NoError(t, err)
+	for _, s := range statuses {
+		assert.True(t, s.Applied)
+		assert.False(t, s.AppliedAt.IsZero())
+	}
+
+	n, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.False(t, db.Migrator().HasColumn("widgets", "color"))
+	assert.True(t, db.Migrator().HasTable("widgets"))
+
+	n, err = m.Down(ctx, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.False(t, db.Migrator().HasTable("widgets"))
+}
+
+func TestMigrator_FailedMigrationRollsBack(t *testing.T) {
+	db := setupTestDB(t)
+	m := NewMigrator(db,
+		Migration{Version: 1, Name: "create_widgets", Up: ExecSQL("CREATE TABLE widgets (id TEXT PRIMARY KEY)")},
+		Migration{Version: 2, Name: "broken", Up: ExecSQL("THIS IS NOT SQL")},
+	)
+	ctx := context.Background()
+
+	n, err := m.Up(ctx)
+	assert.Error(t, err)
+	assert.Equal(t, 1, n)
+
+	statuses, err := m.Status(ctx)
+	assert.NoError(t, err)
+	assert.True(t, statuses[0].Applied)
+	assert.False(t, statuses[1].Applied)
+}
+
+func TestMigrator_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		migrations []Migration
+	}{
+		{
+			name: "duplicate version",
+			migrations: []Migration{
+				{Version: 1, Name: "a", Up: ExecSQL("SELECT 1")},
+				{Version: 1, Name: "b", Up: ExecSQL("SELECT 1")},
+			},
+		},
+		{
+			name:       "missing up",
+			migrations: []Migration{{Version: 1, Name: "a"}},
+		},
+		{
+			name:       "zero version",
+			migrations: []Migration{{Name: "a", Up: ExecSQL("SELECT 1")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMigrator(setupTestDB(t), tt.migrations...).Up(context.Background())
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMigrator_Irreversible(t *testing.T) {
+	db := setupTestDB(t)
+	m := NewMigrator(db, Migration{Version: 1, Name: "create_widgets", Up: ExecSQL("CREATE TABLE widgets (id TEXT PRIMARY KEY)")})
+	ctx := context.Background()
+
+	_, err := m.Up(ctx)
+	assert.NoError(t, err)
+	_, err = m.Down(ctx, 1)
+	assert.Error(t, err)
+}
+
+func TestMigrator_LockTimeout(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.AutoMigrate(&schemaMigrationLock{}))
+	assert.NoError(t, db.Create(&schemaMigrationLock{ID: 1, LockedAt: time.Now()}).Error)
+
+	m := NewMigrator(db, testMigrations()...)
+	m.LockTimeout = 50 * time.Millisecond
+	m.pollInterval = 10 * time.Millisecond
+
+	_, err := m.Up(context.Background())
+	assert.ErrorIs(t, err, ErrLocked)
+	assert.Contains(t, err.Error(), "ago")
+
+	assert.NoError(t, m.Unlock(context.Background()))
+	_, err = m.Up(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestMigrator_StaleLock(t *testing.T) {
+	db := setupTestDB(t)
+	assert.NoError(t, db.Exec(createLockTable).Error)
+	assert.NoError(t, db.Create(&schemaMigrationLock{ID: 1, LockedAt: time.Now().UTC().Add(-time.Hour), LockedBy: "gone:1"}).Error)
+
+	m := NewMigrator(db, testMigrations()...)
+	m.LockTimeout = 50 * time.Millisecond
+	m.pollInterval = 10 * time.Millisecond
+
+	n, err := m.Up(context.Background())
+	assert.NoError(t, err, "a lock older than LockTTL is taken over")
+	assert.Equal(t, 2, n)
+
+	assert.NoError(t, db.Create(&schemaMigrationLock{ID: 1, LockedAt: time.Now().UTC().Add(-time.Hour), LockedBy: "gone:1"}).Error)
+	m.LockTTL = 0
+	_, err = m.Down(context.Background(), 1)
+	assert.ErrorIs(t, err, ErrLocked)
+	assert.Contains(t, err.Error(), `held by "gone:1"`)
+}
+
+func TestMigrator_ConcurrentUp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "concurrent.db")
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	results := make([]int, 4)
+	errs := make([]error, 4)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			db, err := gorm.Open(sqlite.Open(path+"?_busy_timeout=5000"), &gorm.Config{})
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			m := NewMigrator(db, testMigrations()...)
+			m.pollInterval = 5 * time.Millisecond
+			results[i], errs[i] = m.Up(ctx)
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for i := range results {
+		assert.NoError(t, errs[i])
+		total += results[i]
+	}
+	assert.Equal(t, 2, total, "each migration should be applied exactly once")
+}
+
+func TestLoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/0002_add_color.up.sql":      {Data: []byte("ALTER TABLE widgets ADD COLUMN color TEXT")},
+		"migrations/0002_add_color.down.sql":    {Data: []byte("ALTER TABLE widgets DROP COLUMN color")},
+		"migrations/0001_create_widgets.up.sql": {Data: []byte("CREATE TABLE widgets (id TEXT PRIMARY KEY)")},
+		"migrations/README.md":                  {Data: []byte("ignored")},
+	}
+
+	migrations, err := LoadFS(fsys, "migrations")
+	assert.NoError(t, err)
+	assert.Len(t, migrations, 2)
+	assert.Equal(t, int64(1), migrations[0].Version)
+	assert.Equal(t, "create_widgets", migrations[0].Name)
+	assert.Nil(t, migrations[0].Down)
+	assert.Equal(t, "add_color", migrations[1].Name)
+	assert.NotNil(t, migrations[1].Down)
+
+	db := setupTestDB(t)
+	n, err := NewMigrator(db, migrations...).Up(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.True(t, db.Migrator().HasColumn("widgets", "color"))
+}
+
+func TestLoadFS_InvalidNames(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "missing direction", file: "0001_create.sql"},
+		{name: "missing name", file: "0001.up.sql"},
+		{name: "bad version", file: "abc_create.up.sql"},
+		{name: "down without up", file: "0001_create.down.sql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{"m/" + tt.file: {Data: []byte("SELECT 1")}}
+			_, err := LoadFS(fsys, "m")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestMigrations_Baseline(t *testing.T) {
+	db := setupTestDB(t)
+	_, err := NewMigrator(db, Migrations()[0]).Up(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "id"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "start_date"))
+	assert.False(t, db.Migrator().HasColumn(&domain.Event{}, "rrule"), "migration 1 does not follow the models")
+	assert.False(t, db.Migrator().HasColumn(&domain.Event{}, "all_day"))
+	assert.False(t, db.Migrator().HasColumn(&domain.Household{}, "time_zone"))
+}
+
+func TestMigrations_AllDayBackfill(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+	_, err := NewMigrator(db, Migrations()[:2]...).Up(ctx)
+	assert.NoError(t, err)
+	start := time.Date(2025, 5, 5, 9, 0, 0, 0, time.UTC)
+	assert.NoError(t, db.Exec("INSERT INTO events (id, created_at, updated_at, name, start_date, end_date) VALUES (?, ?, ?, ?, ?, ?)",
+		"e1", start, start, "Bins", start, start.Add(time.Hour)).Error)
+
+	_, err = NewMigrator(db, Migrations()...).Up(ctx)
+	assert.NoError(t, err)
+	events, err := repository.NewEventRepository(db).Find(ctx, repository.EventFilter{From: start.AddDate(0, 0, -1), To: start.AddDate(0, 0, 1)})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1, "events from before all_day are found through a window")
+}
+
+func TestMigrations_Domain(t *testing.T) {
+	db := setupTestDB(t)
+	m := NewMigrator(db, Migrations()...)
+	ctx := context.Background()
+
+	_, err := m.Up(ctx)
+	assert.NoError(t, err)
+	assert.True(t, db.Migrator().HasTable(&domain.Household{}))
+	assert.True(t, db.Migrator().HasTable(&domain.Event{}))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "rrule"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_start"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_uid"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Household{}, "time_zone"))
+	assert.True(t, db.Migrator().HasTable(&domain.Recipe{}))
+	assert.True(t, db.Migrator().HasIndex(&domain.MealPlanEntry{}, "idx_meal_plan_household_date"))
+	assert.True(t, db.Migrator().HasTable(&domain.ShoppingItem{}))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&domain.ShoppingList{}))
+	assert.False(t, db.Migrator().HasTable(&domain.ShoppingItem{}))
+	assert.True(t, db.Migrator().HasTable(&domain.Recipe{}))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&domain.Recipe{}))
+	assert.False(t, db.Migrator().HasTable(&domain.Ingredient{}))
+	assert.False(t, db.Migrator().HasTable(&domain.MealPlanEntry{}))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&domain.Household{}, "time_zone"))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_uid"))
+	assert.False(t, db.Migrator().HasColumn(&domain.Event{}, "external_uid"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_start"))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_start"))
+	assert.False(t, db.Migrator().HasColumn(&domain.Event{}, "household_id"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "rrule"))
+
+	_, err = m.Down(ctx, 1)
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn(&domain.Event{}, "rrule"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "name"))
+
+	// Each version's Up restores what its Down removed.
+	_, err = m.Up(ctx)
+	assert.NoError(t, err)
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "rrule"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "recurrence_id"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Event{}, "all_day"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_window"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_series"))
+	assert.True(t, db.Migrator().HasIndex(&domain.Event{}, "idx_events_household_uid"))
+	assert.True(t, db.Migrator().HasColumn(&domain.Household{}, "time_zone"))
+
+	_, err = m.Down(ctx, len(Migrations()))
+	assert.NoError(t, err)
+	assert.False(t, db.Migrator().HasTable(&domain.Household{}))
+}
@@ -0,0 +1,67 @@
+package migrate
+
+import "github.com/hmlylab/common/domain"
+
+// Migrations returns the schema history for the shared domain models.
+// Append new entries; never edit or reorder ones that have shipped.
+func Migrations() []Migration {
+	return []Migration{
+		{
+			Version: 1,
+			Name:    "create_domain_tables",
+			Up:      AutoMigrate(&baselineHousehold{}, &baselineMember{}, &baselineMeal{}, &baselineEvent{}, &baselineUser{}),
+			Down:    DropTables(&baselineUser{}, &baselineEvent{}, &baselineMeal{}, &baselineMember{}, &baselineHousehold{}),
+		},
+		{
+			Version: 2,
+			Name:    "add_event_recurrence",
+			Up:      AddColumns(&domain.Event{}, "rrule", "exdates", "series_id", "recurrence_id"),
+			Down:    DropColumns(&domain.Event{}, "rrule", "exdates", "series_id", "recurrence_id"),
+		},
+		{
+			Version: 3,
+			Name:    "add_event_calendar_indexes",
+			Up: Steps(
+				AddColumns(&domain.Event{}, "household_id", "all_day"),
+				// Rows added before all_day had a default must still match
+				// window queries, which filter on it.
+				ExecSQL("UPDATE events SET all_day = false WHERE all_day IS NULL"),
+				CreateIndexes(&domain.Event{}, "idx_events_window", "idx_events_household_start", "idx_events_assignee_start", "idx_events_entity", "idx_events_series"),
+			),
+			Down: Steps(
+				DropIndexes(&domain.Event{}, "idx_events_window", "idx_events_household_start", "idx_events_assignee_start", "idx_events_entity", "idx_events_series"),
+				DropColumns(&domain.Event{}, "household_id", "all_day"),
+			),
+		},
+		{
+			Version: 4,
+			Name:    "add_event_external_uid",
+			Up: Steps(
+				AddColumns(&domain.Event{}, "external_uid"),
+				CreateIndexes(&domain.Event{}, "idx_events_household_uid"),
+			),
+			Down: Steps(
+				DropIndexes(&domain.Event{}, "idx_events_household_uid"),
+				DropColumns(&domain.Event{}, "external_uid"),
+			),
+		},
+		{
+			Version: 5,
+			Name:    "add_household_time_zone",
+			Up:      AddColumns(&domain.Household{}, "time_zone"),
+			Down:    DropColumns(&domain.Household{}, "time_zone"),
+		},
+		{
+			Version: 6,
+			Name:    "create_meal_planning_tables",
+			Up:      AutoMigrate(&domain.Recipe{}, &domain.Ingredient{}, &domain.MealPlanEntry{}),
+			Down:    DropTables(&domain.MealPlanEntry{}, &domain.Ingredient{}, &domain.Recipe{}),
+		},
+		{
+			Version: 7,
+			Name:    "create_shopping_list_tables",
+			Up:      AutoMigrate(&domain.ShoppingList{}, &domain.ShoppingItem{}),
+			Down:    DropTables(&domain.ShoppingItem{}, &domain.ShoppingList{}),
+		},
+	}
+}
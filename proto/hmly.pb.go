@@ -29,11 +29,166 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MealSlot is the meal of the day a plan entry is for.
+type MealSlot int32
+
+const (
+	MealSlot_MEAL_SLOT_UNSPECIFIED MealSlot = 0 // Not set; rejected when saving
+	MealSlot_MEAL_SLOT_BREAKFAST   MealSlot = 1 // Breakfast
+	MealSlot_MEAL_SLOT_LUNCH       MealSlot = 2 // Lunch
+	MealSlot_MEAL_SLOT_DINNER      MealSlot = 3 // Dinner
+	MealSlot_MEAL_SLOT_SNACK       MealSlot = 4 // Snack
+)
+
+// Enum value maps for MealSlot.
+var (
+	MealSlot_name = map[int32]string{
+		0: "MEAL_SLOT_UNSPECIFIED",
+		1: "MEAL_SLOT_BREAKFAST",
+		2: "MEAL_SLOT_LUNCH",
+		3: "MEAL_SLOT_DINNER",
+		4: "MEAL_SLOT_SNACK",
+	}
+	MealSlot_value = map[string]int32{
+		"MEAL_SLOT_UNSPECIFIED": 0,
+		"MEAL_SLOT_BREAKFAST":   1,
+		"MEAL_SLOT_LUNCH":       2,
+		"MEAL_SLOT_DINNER":      3,
+		"MEAL_SLOT_SNACK":       4,
+	}
+)
+
+func (x MealSlot) Enum() *MealSlot {
+	p := new(MealSlot)
+	*p = x
+	return p
+}
+
+func (x MealSlot) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MealSlot) Descriptor() protoreflect.EnumDescriptor {
+	return file_hmly_proto_enumTypes[0].Descriptor()
+}
+
+func (MealSlot) Type() protoreflect.EnumType {
+	return &file_hmly_proto_enumTypes[0]
+}
+
+func (x MealSlot) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MealSlot.Descriptor instead.
+func (MealSlot) EnumDescriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{0}
+}
+
+// EditScope selects the occurrences of a recurring event that an update
+// changes.
+type EditScope int32
+
+const (
+	EditScope_EDIT_SCOPE_ALL                EditScope = 0 // The whole series
+	EditScope_EDIT_SCOPE_THIS               EditScope = 1 // Only the occurrence at recurrence_id
+	EditScope_EDIT_SCOPE_THIS_AND_FOLLOWING EditScope = 2 // The occurrence at recurrence_id and all later ones
+)
+
+// Enum value maps for EditScope.
+var (
+	EditScope_name = map[int32]string{
+		0: "EDIT_SCOPE_ALL",
+		1: "EDIT_SCOPE_THIS",
+		2: "EDIT_SCOPE_THIS_AND_FOLLOWING",
+	}
+	EditScope_value = map[string]int32{
+		"EDIT_SCOPE_ALL":                0,
+		"EDIT_SCOPE_THIS":               1,
+		"EDIT_SCOPE_THIS_AND_FOLLOWING": 2,
+	}
+)
+
+func (x EditScope) Enum() *EditScope {
+	p := new(EditScope)
+	*p = x
+	return p
+}
+
+func (x EditScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EditScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_hmly_proto_enumTypes[1].Descriptor()
+}
+
+func (EditScope) Type() protoreflect.EnumType {
+	return &file_hmly_proto_enumTypes[1]
+}
+
+func (x EditScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EditScope.Descriptor instead.
+func (EditScope) EnumDescriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{1}
+}
+
+// RotationStrategy selects how AssignRotation picks members.
+type RotationStrategy int32
+
+const (
+	RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN RotationStrategy = 0 // Members take turns
+	RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD  RotationStrategy = 1 // The member with the least work so far goes next
+)
+
+// Enum value maps for RotationStrategy.
+var (
+	RotationStrategy_name = map[int32]string{
+		0: "ROTATION_STRATEGY_ROUND_ROBIN",
+		1: "ROTATION_STRATEGY_LEAST_LOAD",
+	}
+	RotationStrategy_value = map[string]int32{
+		"ROTATION_STRATEGY_ROUND_ROBIN": 0,
+		"ROTATION_STRATEGY_LEAST_LOAD":  1,
+	}
+)
+
+func (x RotationStrategy) Enum() *RotationStrategy {
+	p := new(RotationStrategy)
+	*p = x
+	return p
+}
+
+func (x RotationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RotationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_hmly_proto_enumTypes[2].Descriptor()
+}
+
+func (RotationStrategy) Type() protoreflect.EnumType {
+	return &file_hmly_proto_enumTypes[2]
+}
+
+func (x RotationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RotationStrategy.Descriptor instead.
+func (RotationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{2}
+}
+
 // CreateHouseholdRequest is used to create a new household.
 // Only requires a name to establish the household identity.
 type CreateHouseholdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Display name for the household (e.g., "The Smith Family")
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Display name for the household (e.g., "The Smith Family")
+	TimeZone      *string                `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"` // IANA time zone of the household, e.g. "Europe/London" (default UTC)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,6 +230,13 @@ func (x *CreateHouseholdRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateHouseholdRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
 // GetHouseholdRequest is used to retrieve a specific household.
 // Uses the household's unique identifier for lookup.
 type GetHouseholdRequest struct {
@@ -179,8 +341,9 @@ func (x *GetHouseHoldsRequest) GetLimit() int32 {
 // Requires the household ID and new property values.
 type UpdateHouseholdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // Unique identifier of the household to update
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // New display name for the household
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // Unique identifier of the household to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // New display name for the household
+	TimeZone      *string                `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"` // New IANA time zone for the household
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,6 +392,13 @@ func (x *UpdateHouseholdRequest) GetName() string {
 	return ""
 }
 
+func (x *UpdateHouseholdRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
 // CreateHouseholdResponse is returned after successfully creating a household.
 // Contains the new household's assigned ID and confirmation of the name.
 type CreateHouseholdResponse struct {
@@ -236,6 +406,7 @@ type CreateHouseholdResponse struct {
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Auto-generated unique identifier
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Confirmed household name
 	ErrorMessage  *Error                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if creation failed
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                   // IANA time zone of the household
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -291,6 +462,13 @@ func (x *CreateHouseholdResponse) GetErrorMessage() *Error {
 	return nil
 }
 
+func (x *CreateHouseholdResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 // HouseholdResponse represents a complete household entity.
 // Used for single household retrieval and update operations.
 type HouseholdResponse struct {
@@ -300,6 +478,7 @@ type HouseholdResponse struct {
 	ErrorMessage  *Error                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
 	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // ISO 8601 timestamp of creation
 	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // ISO 8601 timestamp of last update
+	TimeZone      string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                   // IANA time zone of the household
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -369,6 +548,13 @@ func (x *HouseholdResponse) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *HouseholdResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 // Error represents standardized error information across all services.
 // Provides both machine-readable codes and human-readable messages.
 type Error struct {
@@ -1173,34 +1359,31 @@ func (x *MealsResponse) GetErrorMessage() *Error {
 	return nil
 }
 
-// CreateEventRequest creates a new scheduled event.
-// Events are flexible and can be associated with any entity type.
-type CreateEventRequest struct {
+// Ingredient is an amount of something a recipe needs, e.g. 200 g of flour.
+type Ingredient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                               // Descriptive name for the event
-	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`       // ID of the entity this event relates to
-	EntityType    string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // Type of entity (e.g., "household", "meal", "member")
-	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`    // ISO 8601 start date/time for the event
-	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`          // ISO 8601 end date/time for the event
-	AssignedTo    string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"` // User ID of the person assigned to this event
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // What is needed, e.g. "flour"
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // How much, in unit; 0 for "to taste"
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`           // Unit of quantity, e.g. "g", "ml" or "cup"; empty for a count
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`           // Preparation notes, e.g. "finely chopped"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateEventRequest) Reset() {
-	*x = CreateEventRequest{}
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
 	mi := &file_hmly_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateEventRequest) String() string {
+func (x *Ingredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEventRequest) ProtoMessage() {}
+func (*Ingredient) ProtoMessage() {}
 
-func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
 	mi := &file_hmly_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1212,77 +1395,155 @@ func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
-func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
 	return file_hmly_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateEventRequest) GetName() string {
+func (x *Ingredient) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetEntityId() string {
+func (x *Ingredient) GetQuantity() float64 {
 	if x != nil {
-		return x.EntityId
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Ingredient) GetUnit() string {
+	if x != nil {
+		return x.Unit
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetEntityType() string {
+func (x *Ingredient) GetNote() string {
 	if x != nil {
-		return x.EntityType
+		return x.Note
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetStartDate() string {
+// CreateRecipeRequest adds a recipe to a household.
+type CreateRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household this recipe belongs to
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Name of the recipe
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                    // Short description
+	Servings      int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`                         // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`                    // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`                                // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Tags such as "vegetarian"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeRequest) Reset() {
+	*x = CreateRecipeRequest{}
+	mi := &file_hmly_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeRequest) ProtoMessage() {}
+
+func (x *CreateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[21]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRecipeRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetEndDate() string {
+func (x *CreateRecipeRequest) GetName() string {
 	if x != nil {
-		return x.EndDate
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateEventRequest) GetAssignedTo() string {
+func (x *CreateRecipeRequest) GetDescription() string {
 	if x != nil {
-		return x.AssignedTo
+		return x.Description
 	}
 	return ""
 }
 
-// GetEventRequest retrieves a specific event by its unique ID.
-// Used for single event lookup operations.
-type GetEventRequest struct {
+func (x *CreateRecipeRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CreateRecipeRequest) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *CreateRecipeRequest) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CreateRecipeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// GetRecipeRequest retrieves a specific recipe by its unique ID.
+type GetRecipeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the event to retrieve
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the recipe
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventRequest) Reset() {
-	*x = GetEventRequest{}
-	mi := &file_hmly_proto_msgTypes[21]
+func (x *GetRecipeRequest) Reset() {
+	*x = GetRecipeRequest{}
+	mi := &file_hmly_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventRequest) String() string {
+func (x *GetRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventRequest) ProtoMessage() {}
+func (*GetRecipeRequest) ProtoMessage() {}
 
-func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[21]
+func (x *GetRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,44 +1554,45 @@ func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
-func (*GetEventRequest) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetRecipeRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetEventRequest) GetId() string {
+func (x *GetRecipeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// GetEventsRequest retrieves a filtered list of events.
-// Supports filtering by entity type and pagination.
-type GetEventsRequest struct {
+// GetRecipesRequest lists a household's recipes by name.
+type GetRecipesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityType    *string                `protobuf:"bytes,1,opt,name=entityType,proto3,oneof" json:"entityType,omitempty"` // Filter events by entity type (optional)
-	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`        // Number of events to skip (for pagination)
-	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`          // Maximum number of events to return
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household to get recipes for
+	Tag           *string                `protobuf:"bytes,2,opt,name=tag,proto3,oneof" json:"tag,omitempty"`                              // Only recipes with this tag
+	Search        *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                        // Only recipes whose name contains this, ignoring case
+	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                       // Number of recipes to skip
+	Limit         *int32                 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Maximum number of recipes to return
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
-	mi := &file_hmly_proto_msgTypes[22]
+func (x *GetRecipesRequest) Reset() {
+	*x = GetRecipesRequest{}
+	mi := &file_hmly_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsRequest) String() string {
+func (x *GetRecipesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (*GetRecipesRequest) ProtoMessage() {}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[22]
+func (x *GetRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,62 +1603,75 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetRecipesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetEventsRequest) GetEntityType() string {
-	if x != nil && x.EntityType != nil {
-		return *x.EntityType
+func (x *GetRecipesRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
 	}
 	return ""
 }
 
-func (x *GetEventsRequest) GetOffset() int32 {
+func (x *GetRecipesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *GetRecipesRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *GetRecipesRequest) GetOffset() int32 {
 	if x != nil && x.Offset != nil {
 		return *x.Offset
 	}
 	return 0
 }
 
-func (x *GetEventsRequest) GetLimit() int32 {
+func (x *GetRecipesRequest) GetLimit() int32 {
 	if x != nil && x.Limit != nil {
 		return *x.Limit
 	}
 	return 0
 }
 
-// UpdateEventRequest modifies an existing event's properties.
-// Can update any aspect of the event including dates and assignments.
-type UpdateEventRequest struct {
+// UpdateRecipeRequest replaces a recipe, including its ingredients.
+type UpdateRecipeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // Unique identifier of the event to update
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // New descriptive name for the event
-	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`       // New entity ID (if changing associations)
-	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // New entity type (if changing associations)
-	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`    // New ISO 8601 start date/time
-	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`          // New ISO 8601 end date/time
-	AssignedTo    string                 `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"` // New user ID for assignment
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // Unique identifier of the recipe to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Name of the recipe
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Short description
+	Servings      int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`      // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"` // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`             // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`               // Tags such as "vegetarian"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateEventRequest) Reset() {
-	*x = UpdateEventRequest{}
-	mi := &file_hmly_proto_msgTypes[23]
+func (x *UpdateRecipeRequest) Reset() {
+	*x = UpdateRecipeRequest{}
+	mi := &file_hmly_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateEventRequest) String() string {
+func (x *UpdateRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateEventRequest) ProtoMessage() {}
+func (*UpdateRecipeRequest) ProtoMessage() {}
 
-func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[23]
+func (x *UpdateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1407,93 +1682,2632 @@ func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
-func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdateEventRequest) GetId() string {
+func (x *UpdateRecipeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateEventRequest) GetName() string {
+func (x *UpdateRecipeRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateEventRequest) GetEntityId() string {
+func (x *UpdateRecipeRequest) GetDescription() string {
 	if x != nil {
-		return x.EntityId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateEventRequest) GetEntityType() string {
+func (x *UpdateRecipeRequest) GetServings() int32 {
 	if x != nil {
-		return x.EntityType
+		return x.Servings
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateEventRequest) GetStartDate() string {
+func (x *UpdateRecipeRequest) GetIngredients() []*Ingredient {
 	if x != nil {
-		return x.StartDate
+		return x.Ingredients
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEventRequest) GetEndDate() string {
+func (x *UpdateRecipeRequest) GetSteps() []string {
 	if x != nil {
-		return x.EndDate
+		return x.Steps
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateEventRequest) GetAssignedTo() string {
+func (x *UpdateRecipeRequest) GetTags() []string {
 	if x != nil {
-		return x.AssignedTo
+		return x.Tags
 	}
-	return ""
+	return nil
 }
 
-// EventResponse represents a complete event entity.
-// Contains all event details including scheduling and assignment information.
-type EventResponse struct {
+// RecipeResponse represents a complete recipe.
+type RecipeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique event identifier
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Descriptive name of the event
-	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                   // ID of the associated entity
-	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`             // Type of the associated entity
-	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                // ISO 8601 start date/time
-	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                      // ISO 8601 end date/time
-	AssignedTo    string                 `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`             // User ID of the assigned person
-	ErrorMessage  *Error                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // ISO 8601 timestamp of event creation
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique recipe identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the recipe
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                             // Short description
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                                  // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`                             // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`                                         // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                           // Tags such as "vegetarian"
+	ErrorMessage  *Error                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // ISO 8601 timestamp of creation
+	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // ISO 8601 timestamp of last update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeResponse) Reset() {
+	*x = RecipeResponse{}
+	mi := &file_hmly_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeResponse) ProtoMessage() {}
+
+func (x *RecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeResponse.ProtoReflect.Descriptor instead.
+func (*RecipeResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecipeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *RecipeResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// RecipesResponse represents a list of recipes.
+type RecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipes       []*RecipeResponse      `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`                                     // Array of recipes
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipesResponse) Reset() {
+	*x = RecipesResponse{}
+	mi := &file_hmly_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipesResponse) ProtoMessage() {}
+
+func (x *RecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipesResponse.ProtoReflect.Descriptor instead.
+func (*RecipesResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RecipesResponse) GetRecipes() []*RecipeResponse {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *RecipesResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateMealPlanEntryRequest plans a recipe for a meal.
+type CreateMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household whose plan this is
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                  // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,3,opt,name=slot,proto3,enum=api.MealSlot" json:"slot,omitempty"`               // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,4,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`          // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                         // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                                  // Free-form note, e.g. "double for leftovers"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMealPlanEntryRequest) Reset() {
+	*x = CreateMealPlanEntryRequest{}
+	mi := &file_hmly_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *CreateMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateMealPlanEntryRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *CreateMealPlanEntryRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CreateMealPlanEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// GetMealPlanEntryRequest retrieves a specific plan entry by its unique ID.
+type GetMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the plan entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanEntryRequest) Reset() {
+	*x = GetMealPlanEntryRequest{}
+	mi := &file_hmly_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *GetMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetMealPlanEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetMealPlanRequest lists a household's plan by date and then slot.
+type GetMealPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                                // First day, YYYY-MM-DD, inclusive
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                                    // Last day, YYYY-MM-DD, exclusive
+	Slot          *MealSlot              `protobuf:"varint,4,opt,name=slot,proto3,enum=api.MealSlot,oneof" json:"slot,omitempty"`         // Only entries for this meal of the day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanRequest) Reset() {
+	*x = GetMealPlanRequest{}
+	mi := &file_hmly_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanRequest) ProtoMessage() {}
+
+func (x *GetMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetMealPlanRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetSlot() MealSlot {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+// UpdateMealPlanEntryRequest replaces a plan entry.
+type UpdateMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // Unique identifier of the plan entry to update
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                         // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,3,opt,name=slot,proto3,enum=api.MealSlot" json:"slot,omitempty"`      // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,4,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                         // Free-form note, e.g. "double for leftovers"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMealPlanEntryRequest) Reset() {
+	*x = UpdateMealPlanEntryRequest{}
+	mi := &file_hmly_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *UpdateMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateMealPlanEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *UpdateMealPlanEntryRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *UpdateMealPlanEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// MealPlanEntryResponse represents a planned meal with its recipe.
+type MealPlanEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique plan entry identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                           // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,4,opt,name=slot,proto3,enum=api.MealSlot" json:"slot,omitempty"`                        // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`                   // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,6,opt,name=servings,proto3" json:"servings,omitempty"`                                  // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`                                           // Free-form note, e.g. "double for leftovers"
+	Recipe        *RecipeResponse        `protobuf:"bytes,8,opt,name=recipe,proto3" json:"recipe,omitempty"`                                       // The recipe, with its ingredients
+	ErrorMessage  *Error                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // ISO 8601 timestamp of creation
+	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // ISO 8601 timestamp of last update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanEntryResponse) Reset() {
+	*x = MealPlanEntryResponse{}
+	mi := &file_hmly_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanEntryResponse) ProtoMessage() {}
+
+func (x *MealPlanEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanEntryResponse.ProtoReflect.Descriptor instead.
+func (*MealPlanEntryResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MealPlanEntryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *MealPlanEntryResponse) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *MealPlanEntryResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetRecipe() *RecipeResponse {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *MealPlanEntryResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *MealPlanEntryResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// MealPlanResponse represents a household's meal plan.
+type MealPlanResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Entries       []*MealPlanEntryResponse `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                     // Entries by date and then slot
+	ErrorMessage  *Error                   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanResponse) Reset() {
+	*x = MealPlanResponse{}
+	mi := &file_hmly_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanResponse) ProtoMessage() {}
+
+func (x *MealPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanResponse.ProtoReflect.Descriptor instead.
+func (*MealPlanResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MealPlanResponse) GetEntries() []*MealPlanEntryResponse {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *MealPlanResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// ShoppingItem is an amount of something to buy.
+type ShoppingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // Unique item identifier
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // What to buy
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                  // How much, in unit; 0 for "to taste"
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                            // Unit of quantity: "g", "kg", "ml", "l", empty for a count, or as the recipe wrote it
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                            // Free-form note
+	Recipes       []string               `protobuf:"bytes,6,rep,name=recipes,proto3" json:"recipes,omitempty"`                      // Names of the planned recipes that need it
+	Manual        bool                   `protobuf:"varint,7,opt,name=manual,proto3" json:"manual,omitempty"`                       // Whether a member added it by hand
+	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`                     // Whether it has been bought
+	CheckedBy     string                 `protobuf:"bytes,9,opt,name=checked_by,json=checkedBy,proto3" json:"checked_by,omitempty"` // User ID of the member who checked it off
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	mi := &file_hmly_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ShoppingItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetRecipes() []string {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *ShoppingItem) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetCheckedBy() string {
+	if x != nil {
+		return x.CheckedBy
+	}
+	return ""
+}
+
+// CreateShoppingListRequest builds a list from a household's meal plan.
+type CreateShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Name of the list, e.g. "Week 19"
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                                // First planned day, YYYY-MM-DD, inclusive
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                                    // Last planned day, YYYY-MM-DD, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShoppingListRequest) Reset() {
+	*x = CreateShoppingListRequest{}
+	mi := &file_hmly_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShoppingListRequest) ProtoMessage() {}
+
+func (x *CreateShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*CreateShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateShoppingListRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// GetShoppingListRequest retrieves a specific list by its unique ID.
+type GetShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListRequest) Reset() {
+	*x = GetShoppingListRequest{}
+	mi := &file_hmly_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListRequest) ProtoMessage() {}
+
+func (x *GetShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetShoppingListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetShoppingListsRequest lists a household's shopping lists.
+type GetShoppingListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                       // Number of lists to skip
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Maximum number of lists to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListsRequest) Reset() {
+	*x = GetShoppingListsRequest{}
+	mi := &file_hmly_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListsRequest) ProtoMessage() {}
+
+func (x *GetShoppingListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListsRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListsRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetShoppingListsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetShoppingListsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetShoppingListsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// AddShoppingItemRequest adds an item to a list by hand.
+type AddShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                   // What to buy
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`         // How much, in unit
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                   // Unit of quantity, empty for a count
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                   // Free-form note
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddShoppingItemRequest) Reset() {
+	*x = AddShoppingItemRequest{}
+	mi := &file_hmly_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShoppingItemRequest) ProtoMessage() {}
+
+func (x *AddShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*AddShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddShoppingItemRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CheckShoppingItemRequest checks an item off, or back on.
+type CheckShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                       // ID of the item
+	Checked       bool                   `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`            // Whether the item has been bought
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID of the member checking it off
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShoppingItemRequest) Reset() {
+	*x = CheckShoppingItemRequest{}
+	mi := &file_hmly_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShoppingItemRequest) ProtoMessage() {}
+
+func (x *CheckShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*CheckShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CheckShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *CheckShoppingItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeleteShoppingItemRequest removes an item from a list.
+type DeleteShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                       // ID of the item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShoppingItemRequest) Reset() {
+	*x = DeleteShoppingItemRequest{}
+	mi := &file_hmly_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShoppingItemRequest) ProtoMessage() {}
+
+func (x *DeleteShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *DeleteShoppingItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ShoppingListResponse represents a complete shopping list.
+type ShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique list identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the list
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`                                         // First planned day, YYYY-MM-DD
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`                                             // Day after the last planned day, YYYY-MM-DD
+	Items         []*ShoppingItem        `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                         // Items from the plan by name, then manual items
+	ErrorMessage  *Error                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // ISO 8601 timestamp of creation
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // ISO 8601 timestamp of last update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListResponse) Reset() {
+	*x = ShoppingListResponse{}
+	mi := &file_hmly_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListResponse) ProtoMessage() {}
+
+func (x *ShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ShoppingListResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// ShoppingListsResponse represents a list of shopping lists.
+type ShoppingListsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lists         []*ShoppingListResponse `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`                                         // Shopping lists, latest first
+	ErrorMessage  *Error                  `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListsResponse) Reset() {
+	*x = ShoppingListsResponse{}
+	mi := &file_hmly_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListsResponse) ProtoMessage() {}
+
+func (x *ShoppingListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListsResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListsResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ShoppingListsResponse) GetLists() []*ShoppingListResponse {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *ShoppingListsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateEventRequest creates a new scheduled event.
+// Events are flexible and can be associated with any entity type.
+type CreateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // Descriptive name for the event
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`           // ID of the entity this event relates to
+	EntityType    string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`     // Type of entity (e.g., "household", "meal", "member")
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`        // ISO 8601 start date/time for the event
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`              // ISO 8601 end date/time for the event
+	AssignedTo    string                 `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`     // User ID of the person assigned to this event
+	Rrule         string                 `protobuf:"bytes,7,opt,name=rrule,proto3" json:"rrule,omitempty"`                                 // RFC 5545 recurrence rule, e.g. "FREQ=WEEKLY;BYDAY=MO" (empty for one-off events)
+	Exdates       []string               `protobuf:"bytes,8,rep,name=exdates,proto3" json:"exdates,omitempty"`                             // ISO 8601 starts of cancelled occurrences
+	AllDay        bool                   `protobuf:"varint,9,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                // Whether the event spans whole days; dates are then YYYY-MM-DD, end exclusive
+	HouseholdId   string                 `protobuf:"bytes,10,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household the event belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_hmly_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// GetEventRequest retrieves a specific event by its unique ID.
+// Used for single event lookup operations.
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the event to retrieve
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_hmly_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetEventsRequest retrieves a filtered list of events.
+// Supports filtering by entity, assignee, household and time window, and pagination.
+type GetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    *string                `protobuf:"bytes,1,opt,name=entityType,proto3,oneof" json:"entityType,omitempty"`                      // Filter events by entity type (optional)
+	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                             // Number of events to skip (for pagination)
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Maximum number of events to return
+	Start         *string                `protobuf:"bytes,4,opt,name=start,proto3,oneof" json:"start,omitempty"`                                // ISO 8601 start of the window events must overlap, inclusive
+	End           *string                `protobuf:"bytes,5,opt,name=end,proto3,oneof" json:"end,omitempty"`                                    // ISO 8601 end of the window events must overlap, exclusive
+	AssignedTo    *string                `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`    // Filter events by assignee user ID
+	EntityId      *string                `protobuf:"bytes,7,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`          // Filter events by entity ID
+	HouseholdId   *string                `protobuf:"bytes,8,opt,name=household_id,json=householdId,proto3,oneof" json:"household_id,omitempty"` // Filter events by household ID
+	TimeZone      *string                `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`          // IANA time zone whose days all-day events cover (default UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_hmly_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetEventsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetStart() string {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetEnd() string {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetHouseholdId() string {
+	if x != nil && x.HouseholdId != nil {
+		return *x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+// UpdateEventRequest modifies an existing event's properties.
+// Can update any aspect of the event including dates and assignments.
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // Unique identifier of the event to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // New descriptive name for the event
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`              // New entity ID (if changing associations)
+	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`        // New entity type (if changing associations)
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`           // New ISO 8601 start date/time
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                 // New ISO 8601 end date/time
+	AssignedTo    string                 `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`        // New user ID for assignment
+	Rrule         string                 `protobuf:"bytes,8,opt,name=rrule,proto3" json:"rrule,omitempty"`                                    // New recurrence rule (empty for one-off events)
+	Exdates       []string               `protobuf:"bytes,9,rep,name=exdates,proto3" json:"exdates,omitempty"`                                // New ISO 8601 starts of cancelled occurrences
+	RecurrenceId  string                 `protobuf:"bytes,10,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"` // ISO 8601 original start of the occurrence to edit (recurring events only)
+	Scope         EditScope              `protobuf:"varint,11,opt,name=scope,proto3,enum=api.EditScope" json:"scope,omitempty"`               // Which occurrences of a recurring event the update applies to
+	AllDay        bool                   `protobuf:"varint,12,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                  // Whether the event spans whole days
+	HouseholdId   string                 `protobuf:"bytes,13,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // New household ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_hmly_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetRecurrenceId() string {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetScope() EditScope {
+	if x != nil {
+		return x.Scope
+	}
+	return EditScope_EDIT_SCOPE_ALL
+}
+
+func (x *UpdateEventRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *UpdateEventRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// EventResponse represents a complete event entity.
+// Contains all event details including scheduling and assignment information.
+type EventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique event identifier
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Descriptive name of the event
+	EntityId      string                 `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                   // ID of the associated entity
+	EntityType    string                 `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`             // Type of the associated entity
+	StartDate     string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                // ISO 8601 start date/time
+	EndDate       string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                      // ISO 8601 end date/time
+	AssignedTo    string                 `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`             // User ID of the assigned person
+	ErrorMessage  *Error                 `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // ISO 8601 timestamp of event creation
 	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // ISO 8601 timestamp of last update
+	Rrule         string                 `protobuf:"bytes,11,opt,name=rrule,proto3" json:"rrule,omitempty"`                                        // RFC 5545 recurrence rule (empty for one-off events)
+	Exdates       []string               `protobuf:"bytes,12,rep,name=exdates,proto3" json:"exdates,omitempty"`                                    // ISO 8601 starts of cancelled occurrences
+	SeriesId      string                 `protobuf:"bytes,13,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`                  // ID of the series this event or occurrence belongs to
+	RecurrenceId  string                 `protobuf:"bytes,14,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"`      // ISO 8601 original start of the occurrence
+	AllDay        bool                   `protobuf:"varint,15,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                       // Whether the event spans whole days
+	HouseholdId   string                 `protobuf:"bytes,16,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`         // ID of the household the event belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_hmly_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventResponse) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *EventResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *EventResponse) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *EventResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *EventResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *EventResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *EventResponse) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *EventResponse) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *EventResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetRecurrenceId() string {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *EventResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// ListOccurrencesRequest selects the events to expand and the window to
+// expand them in.
+type ListOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    *string                `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"`    // Filter events by entity type (optional)
+	EntityId      *string                `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`          // Filter events by entity ID (optional)
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                                      // ISO 8601 start of the window, inclusive
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                                          // ISO 8601 end of the window, exclusive
+	HouseholdId   *string                `protobuf:"bytes,5,opt,name=household_id,json=householdId,proto3,oneof" json:"household_id,omitempty"` // Filter events by household ID (optional)
+	AssignedTo    *string                `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`    // Filter events by assignee user ID (optional)
+	TimeZone      *string                `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`          // IANA time zone whose days all-day events cover (default UTC)
+	Offset        *int32                 `protobuf:"varint,8,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                             // Number of occurrences to skip (for pagination)
+	Limit         *int32                 `protobuf:"varint,9,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Maximum number of occurrences to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOccurrencesRequest) Reset() {
+	*x = ListOccurrencesRequest{}
+	mi := &file_hmly_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListOccurrencesRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetHouseholdId() string {
+	if x != nil && x.HouseholdId != nil {
+		return *x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListOccurrencesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// EventsResponse represents a list of events.
+// Used for bulk event retrieval and filtered query operations.
+type EventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*EventResponse       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                                       // Array of event entities
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsResponse) Reset() {
+	*x = EventsResponse{}
+	mi := &file_hmly_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse) ProtoMessage() {}
+
+func (x *EventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *EventsResponse) GetEvents() []*EventResponse {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// ImportEventsRequest carries an iCalendar file to import.
+type ImportEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // Household the events are imported into
+	Ics           string                 `protobuf:"bytes,2,opt,name=ics,proto3" json:"ics,omitempty"`                                       // iCalendar (RFC 5545) data
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                  // Report the changes without saving them
+	TimeZone      *string                `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`       // IANA time zone for floating times (default UTC)
+	EntityType    *string                `protobuf:"bytes,5,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"` // Entity the events belong to (default the household)
+	EntityId      *string                `protobuf:"bytes,6,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`       // Entity ID the events belong to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEventsRequest) Reset() {
+	*x = ImportEventsRequest{}
+	mi := &file_hmly_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEventsRequest) ProtoMessage() {}
+
+func (x *ImportEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEventsRequest.ProtoReflect.Descriptor instead.
+func (*ImportEventsRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportEventsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEventsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+// ImportedEvent is what an import did, or would do, with one VEVENT.
+type ImportedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // "create", "update" or "unchanged"
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`       // UID of the VEVENT
+	Event         *EventResponse         `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`   // The event as saved, or as it would be saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedEvent) Reset() {
+	*x = ImportedEvent{}
+	mi := &file_hmly_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedEvent) ProtoMessage() {}
+
+func (x *ImportedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedEvent.ProtoReflect.Descriptor instead.
+func (*ImportedEvent) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportedEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetEvent() *EventResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// ImportProblem is a VEVENT that was skipped.
+type ImportProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`         // UID of the VEVENT, if it had one
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`      // Line the VEVENT starts on
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Why it was skipped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProblem) Reset() {
+	*x = ImportProblem{}
+	mi := &file_hmly_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProblem) ProtoMessage() {}
+
+func (x *ImportProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProblem.ProtoReflect.Descriptor instead.
+func (*ImportProblem) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportProblem) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportProblem) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportEventsResponse reports an import.
+type ImportEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`                                    // Number of events created
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`                                    // Number of events updated
+	Unchanged     int32                  `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`                                // Number of events already up to date
+	Events        []*ImportedEvent       `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`                                       // One entry per imported VEVENT
+	Problems      []*ImportProblem       `protobuf:"bytes,5,rep,name=problems,proto3" json:"problems,omitempty"`                                   // VEVENTs that were skipped
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Whether nothing was saved
+	ErrorMessage  *Error                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEventsResponse) Reset() {
+	*x = ImportEventsResponse{}
+	mi := &file_hmly_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEventsResponse) ProtoMessage() {}
+
+func (x *ImportEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEventsResponse.ProtoReflect.Descriptor instead.
+func (*ImportEventsResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ImportEventsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetEvents() []*ImportedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ImportEventsResponse) GetProblems() []*ImportProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *ImportEventsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEventsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// Unavailability is a period a member cannot take occurrences in.
+type Unavailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // ISO 8601 start of the period, inclusive
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // ISO 8601 end of the period, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unavailability) Reset() {
+	*x = Unavailability{}
+	mi := &file_hmly_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unavailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unavailability) ProtoMessage() {}
+
+func (x *Unavailability) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unavailability.ProtoReflect.Descriptor instead.
+func (*Unavailability) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Unavailability) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Unavailability) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// RotationMember is a member taking part in a rotation.
+type RotationMember struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                       // User ID of the member
+	Unavailable      []*Unavailability      `protobuf:"bytes,2,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                                           // Periods the member is away
+	ExcludedWeekdays []int32                `protobuf:"varint,3,rep,packed,name=excluded_weekdays,json=excludedWeekdays,proto3" json:"excluded_weekdays,omitempty"` // Days the member never takes, 0 = Sunday to 6 = Saturday
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RotationMember) Reset() {
+	*x = RotationMember{}
+	mi := &file_hmly_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationMember) ProtoMessage() {}
+
+func (x *RotationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationMember.ProtoReflect.Descriptor instead.
+func (*RotationMember) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RotationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RotationMember) GetUnavailable() []*Unavailability {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+func (x *RotationMember) GetExcludedWeekdays() []int32 {
+	if x != nil {
+		return x.ExcludedWeekdays
+	}
+	return nil
+}
+
+// AssignRotationRequest shares a recurring event's occurrences in a window.
+type AssignRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`               // ID of the recurring event
+	Strategy      RotationStrategy       `protobuf:"varint,2,opt,name=strategy,proto3,enum=api.RotationStrategy" json:"strategy,omitempty"` // How members are picked
+	Members       []*RotationMember      `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`                              // Members in turn order
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`                                  // ISO 8601 start of the window, inclusive
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`                                      // ISO 8601 end of the window, exclusive
+	TimeZone      *string                `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`      // IANA time zone for weekdays and all-day events (default UTC)
+	After         *string                `protobuf:"bytes,7,opt,name=after,proto3,oneof" json:"after,omitempty"`                            // User ID of the member who took the previous occurrence
+	DryRun        bool                   `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                 // Report the assignments without saving them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRotationRequest) Reset() {
+	*x = AssignRotationRequest{}
+	mi := &file_hmly_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRotationRequest) ProtoMessage() {}
+
+func (x *AssignRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRotationRequest.ProtoReflect.Descriptor instead.
+func (*AssignRotationRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AssignRotationRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetStrategy() RotationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN
+}
+
+func (x *AssignRotationRequest) GetMembers() []*RotationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AssignRotationRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// RotationAssignment gives one occurrence to a member.
+type RotationAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurrenceId  string                 `protobuf:"bytes,1,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"` // ISO 8601 original start of the occurrence
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`          // ISO 8601 start of the occurrence
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                // ISO 8601 end of the occurrence
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // Member assigned, empty if nobody was available
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventResponse) Reset() {
-	*x = EventResponse{}
-	mi := &file_hmly_proto_msgTypes[24]
+func (x *RotationAssignment) Reset() {
+	*x = RotationAssignment{}
+	mi := &file_hmly_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventResponse) String() string {
+func (x *RotationAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventResponse) ProtoMessage() {}
+func (*RotationAssignment) ProtoMessage() {}
 
-func (x *EventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[24]
+func (x *RotationAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,106 +4318,270 @@ func (x *EventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
-func (*EventResponse) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RotationAssignment.ProtoReflect.Descriptor instead.
+func (*RotationAssignment) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *EventResponse) GetId() string {
+func (x *RotationAssignment) GetRecurrenceId() string {
 	if x != nil {
-		return x.Id
+		return x.RecurrenceId
 	}
 	return ""
 }
 
-func (x *EventResponse) GetName() string {
+func (x *RotationAssignment) GetStartDate() string {
 	if x != nil {
-		return x.Name
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *EventResponse) GetEntityId() string {
+func (x *RotationAssignment) GetEndDate() string {
 	if x != nil {
-		return x.EntityId
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *EventResponse) GetEntityType() string {
+func (x *RotationAssignment) GetUserId() string {
 	if x != nil {
-		return x.EntityType
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *EventResponse) GetStartDate() string {
+// AssignRotationResponse lists the assignments made, or that would be made.
+type AssignRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*RotationAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`                             // One per occurrence in the window
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Whether nothing was saved
+	ErrorMessage  *Error                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRotationResponse) Reset() {
+	*x = AssignRotationResponse{}
+	mi := &file_hmly_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRotationResponse) ProtoMessage() {}
+
+func (x *AssignRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[57]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRotationResponse.ProtoReflect.Descriptor instead.
+func (*AssignRotationResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AssignRotationResponse) GetAssignments() []*RotationAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AssignRotationResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AssignRotationResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// GetWorkloadRequest selects the period and events to summarize.
+type GetWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // ID of the household
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                                   // ISO 8601 start of the period, inclusive
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                                       // ISO 8601 end of the period, exclusive
+	TimeZone      *string                `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`       // IANA time zone whose days all-day events cover (default UTC)
+	EntityType    *string                `protobuf:"bytes,5,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"` // Only count events of this entity type, e.g. "chore"
+	UserIds       []string               `protobuf:"bytes,6,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`                // Members to include even if they have nothing assigned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadRequest) Reset() {
+	*x = GetWorkloadRequest{}
+	mi := &file_hmly_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadRequest) ProtoMessage() {}
+
+func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetWorkloadRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
 	}
 	return ""
 }
 
-func (x *EventResponse) GetEndDate() string {
+func (x *GetWorkloadRequest) GetStart() string {
 	if x != nil {
-		return x.EndDate
+		return x.Start
 	}
 	return ""
 }
 
-func (x *EventResponse) GetAssignedTo() string {
+func (x *GetWorkloadRequest) GetEnd() string {
 	if x != nil {
-		return x.AssignedTo
+		return x.End
 	}
 	return ""
 }
 
-func (x *EventResponse) GetErrorMessage() *Error {
+func (x *GetWorkloadRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetUserIds() []string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.UserIds
 	}
 	return nil
 }
 
-func (x *EventResponse) GetCreatedAt() string {
+// MemberWorkload is how much one member is assigned.
+type MemberWorkload struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                             // User ID of the member, empty for unassigned occurrences
+	Count           int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                                            // Number of occurrences
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // Total time assigned, in seconds
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MemberWorkload) Reset() {
+	*x = MemberWorkload{}
+	mi := &file_hmly_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberWorkload) ProtoMessage() {}
+
+func (x *MemberWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[59]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *EventResponse) GetUpdatedAt() string {
+// Deprecated: Use MemberWorkload.ProtoReflect.Descriptor instead.
+func (*MemberWorkload) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MemberWorkload) GetUserId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.UserId
 	}
 	return ""
 }
 
-// EventsResponse represents a list of events.
-// Used for bulk event retrieval and filtered query operations.
-type EventsResponse struct {
+func (x *MemberWorkload) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MemberWorkload) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+// WorkloadResponse lists the workload of each member.
+type WorkloadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*EventResponse       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                                       // Array of event entities
+	Workloads     []*MemberWorkload      `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`                                 // Ordered by user ID
 	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventsResponse) Reset() {
-	*x = EventsResponse{}
-	mi := &file_hmly_proto_msgTypes[25]
+func (x *WorkloadResponse) Reset() {
+	*x = WorkloadResponse{}
+	mi := &file_hmly_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventsResponse) String() string {
+func (x *WorkloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventsResponse) ProtoMessage() {}
+func (*WorkloadResponse) ProtoMessage() {}
 
-func (x *EventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[25]
+func (x *WorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hmly_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,19 +4592,19 @@ func (x *EventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
-func (*EventsResponse) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use WorkloadResponse.ProtoReflect.Descriptor instead.
+func (*WorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_hmly_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *EventsResponse) GetEvents() []*EventResponse {
+func (x *WorkloadResponse) GetWorkloads() []*MemberWorkload {
 	if x != nil {
-		return x.Events
+		return x.Workloads
 	}
 	return nil
 }
 
-func (x *EventsResponse) GetErrorMessage() *Error {
+func (x *WorkloadResponse) GetErrorMessage() *Error {
 	if x != nil {
 		return x.ErrorMessage
 	}
@@ -1644,7 +4622,7 @@ type VerifyTokenRequest struct {
 
 func (x *VerifyTokenRequest) Reset() {
 	*x = VerifyTokenRequest{}
-	mi := &file_hmly_proto_msgTypes[26]
+	mi := &file_hmly_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1656,7 +4634,7 @@ func (x *VerifyTokenRequest) String() string {
 func (*VerifyTokenRequest) ProtoMessage() {}
 
 func (x *VerifyTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[26]
+	mi := &file_hmly_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1669,7 +4647,7 @@ func (x *VerifyTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyTokenRequest.ProtoReflect.Descriptor instead.
 func (*VerifyTokenRequest) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{26}
+	return file_hmly_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *VerifyTokenRequest) GetToken() string {
@@ -1692,7 +4670,7 @@ type VerifyTokenResponse struct {
 
 func (x *VerifyTokenResponse) Reset() {
 	*x = VerifyTokenResponse{}
-	mi := &file_hmly_proto_msgTypes[27]
+	mi := &file_hmly_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1704,7 +4682,7 @@ func (x *VerifyTokenResponse) String() string {
 func (*VerifyTokenResponse) ProtoMessage() {}
 
 func (x *VerifyTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hmly_proto_msgTypes[27]
+	mi := &file_hmly_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,7 +4695,7 @@ func (x *VerifyTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyTokenResponse.ProtoReflect.Descriptor instead.
 func (*VerifyTokenResponse) Descriptor() ([]byte, []int) {
-	return file_hmly_proto_rawDescGZIP(), []int{27}
+	return file_hmly_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *VerifyTokenResponse) GetValid() bool {
@@ -1746,23 +4724,30 @@ var File_hmly_proto protoreflect.FileDescriptor
 const file_hmly_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"hmly.proto\x12\x03api\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\",\n" +
+	"hmly.proto\x12\x03api\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\"\\\n" +
 	"\x16CreateHouseholdRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"%\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\ttime_zone\x18\x02 \x01(\tH\x00R\btimeZone\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zone\"%\n" +
 	"\x13GetHouseholdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
 	"\x14GetHouseHoldsRequest\x12\x16\n" +
 	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"<\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"l\n" +
 	"\x16UpdateHouseholdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x85\x01\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\ttime_zone\x18\x03 \x01(\tH\x00R\btimeZone\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zone\"\xa2\x01\n" +
 	"\x17CreateHouseholdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
 	"\rerror_message\x18\x03 \x01(\v2\n" +
-	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"\xbd\x01\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZoneB\x10\n" +
+	"\x0e_error_message\"\xda\x01\n" +
 	"\x11HouseholdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
@@ -1771,7 +4756,8 @@ const file_hmly_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\tR\tupdatedAtB\x10\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZoneB\x10\n" +
 	"\x0e_error_message\"5\n" +
 	"\x05Error\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
@@ -1836,7 +4822,164 @@ const file_hmly_proto_rawDesc = "" +
 	"\x05meals\x18\x01 \x03(\v2\x11.api.MealResponseR\x05meals\x124\n" +
 	"\rerror_message\x18\x02 \x01(\v2\n" +
 	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"\xc1\x01\n" +
+	"\x0e_error_message\"d\n" +
+	"\n" +
+	"Ingredient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xe7\x01\n" +
+	"\x13CreateRecipeRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x121\n" +
+	"\vingredients\x18\x05 \x03(\v2\x0f.api.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\x06 \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"\"\n" +
+	"\x10GetRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xca\x01\n" +
+	"\x11GetRecipesRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tH\x00R\x03tag\x88\x01\x01\x12\x1b\n" +
+	"\x06search\x18\x03 \x01(\tH\x01R\x06search\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x04 \x01(\x05H\x02R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x05 \x01(\x05H\x03R\x05limit\x88\x01\x01B\x06\n" +
+	"\x04_tagB\t\n" +
+	"\a_searchB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\xd4\x01\n" +
+	"\x13UpdateRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x121\n" +
+	"\vingredients\x18\x05 \x03(\v2\x0f.api.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\x06 \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"\xf8\x02\n" +
+	"\x0eRecipeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x121\n" +
+	"\vingredients\x18\x06 \x03(\v2\x0f.api.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\a \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x124\n" +
+	"\rerror_message\x18\t \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x88\x01\n" +
+	"\x0fRecipesResponse\x12-\n" +
+	"\arecipes\x18\x01 \x03(\v2\x13.api.RecipeResponseR\arecipes\x124\n" +
+	"\rerror_message\x18\x02 \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xc3\x01\n" +
+	"\x1aCreateMealPlanEntryRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12!\n" +
+	"\x04slot\x18\x03 \x01(\x0e2\r.api.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x04 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\")\n" +
+	"\x17GetMealPlanEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
+	"\x12GetMealPlanRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12&\n" +
+	"\x04slot\x18\x04 \x01(\x0e2\r.api.MealSlotH\x00R\x04slot\x88\x01\x01B\a\n" +
+	"\x05_slot\"\xb0\x01\n" +
+	"\x1aUpdateMealPlanEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12!\n" +
+	"\x04slot\x18\x03 \x01(\x0e2\r.api.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x04 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"\x81\x03\n" +
+	"\x15MealPlanEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12!\n" +
+	"\x04slot\x18\x04 \x01(\x0e2\r.api.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x05 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12+\n" +
+	"\x06recipe\x18\b \x01(\v2\x13.api.RecipeResponseR\x06recipe\x124\n" +
+	"\rerror_message\x18\t \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x90\x01\n" +
+	"\x10MealPlanResponse\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.api.MealPlanEntryResponseR\aentries\x124\n" +
+	"\rerror_message\x18\x02 \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xe1\x01\n" +
+	"\fShoppingItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x18\n" +
+	"\arecipes\x18\x06 \x03(\tR\arecipes\x12\x16\n" +
+	"\x06manual\x18\a \x01(\bR\x06manual\x12\x18\n" +
+	"\achecked\x18\b \x01(\bR\achecked\x12\x1d\n" +
+	"\n" +
+	"checked_by\x18\t \x01(\tR\tcheckedBy\"z\n" +
+	"\x19CreateShoppingListRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"(\n" +
+	"\x16GetShoppingListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x89\x01\n" +
+	"\x17GetShoppingListsRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x00R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\x89\x01\n" +
+	"\x16AddShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"v\n" +
+	"\x18CheckShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\bR\achecked\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"D\n" +
+	"\x19DeleteShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xb4\x02\n" +
+	"\x14ShoppingListResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\x12'\n" +
+	"\x05items\x18\x06 \x03(\v2\x11.api.ShoppingItemR\x05items\x124\n" +
+	"\rerror_message\x18\a \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x90\x01\n" +
+	"\x15ShoppingListsResponse\x12/\n" +
+	"\x05lists\x18\x01 \x03(\v2\x19.api.ShoppingListResponseR\x05lists\x124\n" +
+	"\rerror_message\x18\x02 \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xad\x02\n" +
 	"\x12CreateEventRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
 	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x1f\n" +
@@ -1846,18 +4989,38 @@ const file_hmly_proto_rawDesc = "" +
 	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
 	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1f\n" +
 	"\vassigned_to\x18\x06 \x01(\tR\n" +
-	"assignedTo\"!\n" +
+	"assignedTo\x12\x14\n" +
+	"\x05rrule\x18\a \x01(\tR\x05rrule\x12\x18\n" +
+	"\aexdates\x18\b \x03(\tR\aexdates\x12\x17\n" +
+	"\aall_day\x18\t \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\n" +
+	" \x01(\tR\vhouseholdId\"!\n" +
 	"\x0fGetEventRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa6\x03\n" +
 	"\x10GetEventsRequest\x12#\n" +
 	"\n" +
 	"entityType\x18\x01 \x01(\tH\x00R\n" +
 	"entityType\x88\x01\x01\x12\x1b\n" +
 	"\x06offset\x18\x02 \x01(\x05H\x01R\x06offset\x88\x01\x01\x12\x19\n" +
-	"\x05limit\x18\x03 \x01(\x05H\x02R\x05limit\x88\x01\x01B\r\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x02R\x05limit\x88\x01\x01\x12\x19\n" +
+	"\x05start\x18\x04 \x01(\tH\x03R\x05start\x88\x01\x01\x12\x15\n" +
+	"\x03end\x18\x05 \x01(\tH\x04R\x03end\x88\x01\x01\x12$\n" +
+	"\vassigned_to\x18\x06 \x01(\tH\x05R\n" +
+	"assignedTo\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\a \x01(\tH\x06R\bentityId\x88\x01\x01\x12&\n" +
+	"\fhousehold_id\x18\b \x01(\tH\aR\vhouseholdId\x88\x01\x01\x12 \n" +
+	"\ttime_zone\x18\t \x01(\tH\bR\btimeZone\x88\x01\x01B\r\n" +
 	"\v_entityTypeB\t\n" +
 	"\a_offsetB\b\n" +
-	"\x06_limit\"\xd1\x01\n" +
+	"\x06_limitB\b\n" +
+	"\x06_startB\x06\n" +
+	"\x04_endB\x0e\n" +
+	"\f_assigned_toB\f\n" +
+	"\n" +
+	"_entity_idB\x0f\n" +
+	"\r_household_idB\f\n" +
+	"\n" +
+	"_time_zone\"\x88\x03\n" +
 	"\x12UpdateEventRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -1868,7 +5031,14 @@ const file_hmly_proto_rawDesc = "" +
 	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
 	"\bend_date\x18\x06 \x01(\tR\aendDate\x12\x1f\n" +
 	"\vassigned_to\x18\a \x01(\tR\n" +
-	"assignedTo\"\xd2\x02\n" +
+	"assignedTo\x12\x14\n" +
+	"\x05rrule\x18\b \x01(\tR\x05rrule\x12\x18\n" +
+	"\aexdates\x18\t \x03(\tR\aexdates\x12#\n" +
+	"\rrecurrence_id\x18\n" +
+	" \x01(\tR\frecurrenceId\x12$\n" +
+	"\x05scope\x18\v \x01(\x0e2\x0e.api.EditScopeR\x05scope\x12\x17\n" +
+	"\aall_day\x18\f \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\r \x01(\tR\vhouseholdId\"\x80\x04\n" +
 	"\rEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -1886,12 +5056,121 @@ const file_hmly_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAtB\x10\n" +
-	"\x0e_error_message\"\x84\x01\n" +
+	" \x01(\tR\tupdatedAt\x12\x14\n" +
+	"\x05rrule\x18\v \x01(\tR\x05rrule\x12\x18\n" +
+	"\aexdates\x18\f \x03(\tR\aexdates\x12\x1b\n" +
+	"\tseries_id\x18\r \x01(\tR\bseriesId\x12#\n" +
+	"\rrecurrence_id\x18\x0e \x01(\tR\frecurrenceId\x12\x17\n" +
+	"\aall_day\x18\x0f \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\x10 \x01(\tR\vhouseholdIdB\x10\n" +
+	"\x0e_error_message\"\x92\x03\n" +
+	"\x16ListOccurrencesRequest\x12$\n" +
+	"\ventity_type\x18\x01 \x01(\tH\x00R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x02 \x01(\tH\x01R\bentityId\x88\x01\x01\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\x12&\n" +
+	"\fhousehold_id\x18\x05 \x01(\tH\x02R\vhouseholdId\x88\x01\x01\x12$\n" +
+	"\vassigned_to\x18\x06 \x01(\tH\x03R\n" +
+	"assignedTo\x88\x01\x01\x12 \n" +
+	"\ttime_zone\x18\a \x01(\tH\x04R\btimeZone\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\b \x01(\x05H\x05R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\t \x01(\x05H\x06R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_idB\x0f\n" +
+	"\r_household_idB\x0e\n" +
+	"\f_assigned_toB\f\n" +
+	"\n" +
+	"_time_zoneB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\x84\x01\n" +
 	"\x0eEventsResponse\x12*\n" +
 	"\x06events\x18\x01 \x03(\v2\x12.api.EventResponseR\x06events\x124\n" +
 	"\rerror_message\x18\x02 \x01(\v2\n" +
 	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xf9\x01\n" +
+	"\x13ImportEventsRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x10\n" +
+	"\x03ics\x18\x02 \x01(\tR\x03ics\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12 \n" +
+	"\ttime_zone\x18\x04 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12$\n" +
+	"\ventity_type\x18\x05 \x01(\tH\x01R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x06 \x01(\tH\x02R\bentityId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zoneB\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_id\"c\n" +
+	"\rImportedEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12(\n" +
+	"\x05event\x18\x03 \x01(\v2\x12.api.EventResponseR\x05event\"O\n" +
+	"\rImportProblem\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa5\x02\n" +
+	"\x14ImportEventsResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\x05R\tunchanged\x12*\n" +
+	"\x06events\x18\x04 \x03(\v2\x12.api.ImportedEventR\x06events\x12.\n" +
+	"\bproblems\x18\x05 \x03(\v2\x12.api.ImportProblemR\bproblems\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x124\n" +
+	"\rerror_message\x18\a \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"8\n" +
+	"\x0eUnavailability\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x8d\x01\n" +
+	"\x0eRotationMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x125\n" +
+	"\vunavailable\x18\x02 \x03(\v2\x13.api.UnavailabilityR\vunavailable\x12+\n" +
+	"\x11excluded_weekdays\x18\x03 \x03(\x05R\x10excludedWeekdays\"\xaa\x02\n" +
+	"\x15AssignRotationRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x121\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x15.api.RotationStrategyR\bstrategy\x12-\n" +
+	"\amembers\x18\x03 \x03(\v2\x13.api.RotationMemberR\amembers\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\x12 \n" +
+	"\ttime_zone\x18\x06 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\a \x01(\tH\x01R\x05after\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_time_zoneB\b\n" +
+	"\x06_after\"\x8c\x01\n" +
+	"\x12RotationAssignment\x12#\n" +
+	"\rrecurrence_id\x18\x01 \x01(\tR\frecurrenceId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\xb4\x01\n" +
+	"\x16AssignRotationResponse\x129\n" +
+	"\vassignments\x18\x01 \x03(\v2\x17.api.RotationAssignmentR\vassignments\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x124\n" +
+	"\rerror_message\x18\x03 \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xe0\x01\n" +
+	"\x12GetWorkloadRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12 \n" +
+	"\ttime_zone\x18\x04 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12$\n" +
+	"\ventity_type\x18\x05 \x01(\tH\x01R\n" +
+	"entityType\x88\x01\x01\x12\x19\n" +
+	"\buser_ids\x18\x06 \x03(\tR\auserIdsB\f\n" +
+	"\n" +
+	"_time_zoneB\x0e\n" +
+	"\f_entity_type\"j\n" +
+	"\x0eMemberWorkload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"\x8d\x01\n" +
+	"\x10WorkloadResponse\x121\n" +
+	"\tworkloads\x18\x01 \x03(\v2\x13.api.MemberWorkloadR\tworkloads\x124\n" +
+	"\rerror_message\x18\x02 \x01(\v2\n" +
+	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
 	"\x0e_error_message\"*\n" +
 	"\x12VerifyTokenRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"\x8c\x01\n" +
@@ -1900,7 +5179,20 @@ const file_hmly_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x124\n" +
 	"\rerror_message\x18\x03 \x01(\v2\n" +
 	".api.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message2\xfb\x03\n" +
+	"\x0e_error_message*~\n" +
+	"\bMealSlot\x12\x19\n" +
+	"\x15MEAL_SLOT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MEAL_SLOT_BREAKFAST\x10\x01\x12\x13\n" +
+	"\x0fMEAL_SLOT_LUNCH\x10\x02\x12\x14\n" +
+	"\x10MEAL_SLOT_DINNER\x10\x03\x12\x13\n" +
+	"\x0fMEAL_SLOT_SNACK\x10\x04*W\n" +
+	"\tEditScope\x12\x12\n" +
+	"\x0eEDIT_SCOPE_ALL\x10\x00\x12\x13\n" +
+	"\x0fEDIT_SCOPE_THIS\x10\x01\x12!\n" +
+	"\x1dEDIT_SCOPE_THIS_AND_FOLLOWING\x10\x02*W\n" +
+	"\x10RotationStrategy\x12!\n" +
+	"\x1dROTATION_STRATEGY_ROUND_ROBIN\x10\x00\x12 \n" +
+	"\x1cROTATION_STRATEGY_LEAST_LOAD\x10\x012\xfb\x03\n" +
 	"\x10HouseholdService\x12a\n" +
 	"\x0fCreateHousehold\x12\x1b.api.CreateHouseholdRequest\x1a\x16.api.HouseholdResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/households\x12]\n" +
 	"\fGetHousehold\x12\x18.api.GetHouseholdRequest\x1a\x16.api.HouseholdResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/households/{id}\x12[\n" +
@@ -1913,7 +5205,7 @@ const file_hmly_proto_rawDesc = "" +
 	"\n" +
 	"GetMembers\x12\x16.api.GetMembersRequest\x1a\x14.api.MembersResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/members/household/{household_id}\x12Z\n" +
 	"\fUpdateMember\x12\x18.api.UpdateMemberRequest\x1a\x13.api.MemberResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/members/{id}\x12W\n" +
-	"\fDeleteMember\x12\x15.api.GetMemberRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/members/{id}2\xb0\x03\n" +
+	"\fDeleteMember\x12\x15.api.GetMemberRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/members/{id}2\x9c\v\n" +
 	"\vMealService\x12M\n" +
 	"\n" +
 	"CreateMeal\x12\x16.api.CreateMealRequest\x1a\x11.api.MealResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/meals\x12I\n" +
@@ -1922,7 +5214,27 @@ const file_hmly_proto_rawDesc = "" +
 	"\n" +
 	"UpdateMeal\x12\x16.api.UpdateMealRequest\x1a\x11.api.MealResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v1/meals/{id}\x12Q\n" +
 	"\n" +
-	"DeleteMeal\x12\x13.api.GetMealRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/meals/{id}2\xab\x03\n" +
+	"DeleteMeal\x12\x13.api.GetMealRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v1/meals/{id}\x12U\n" +
+	"\fCreateRecipe\x12\x18.api.CreateRecipeRequest\x1a\x13.api.RecipeResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/recipes\x12Q\n" +
+	"\tGetRecipe\x12\x15.api.GetRecipeRequest\x1a\x13.api.RecipeResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/recipes/{id}\x12h\n" +
+	"\n" +
+	"GetRecipes\x12\x16.api.GetRecipesRequest\x1a\x14.api.RecipesResponse\",\x82\xd3\xe4\x93\x02&\x12$/v1/recipes/household/{household_id}\x12Z\n" +
+	"\fUpdateRecipe\x12\x18.api.UpdateRecipeRequest\x1a\x13.api.RecipeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/recipes/{id}\x12W\n" +
+	"\fDeleteRecipe\x12\x15.api.GetRecipeRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/recipes/{id}\x12l\n" +
+	"\x13CreateMealPlanEntry\x12\x1f.api.CreateMealPlanEntryRequest\x1a\x1a.api.MealPlanEntryResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/meal-plan\x12h\n" +
+	"\x10GetMealPlanEntry\x12\x1c.api.GetMealPlanEntryRequest\x1a\x1a.api.MealPlanEntryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/meal-plan/{id}\x12m\n" +
+	"\vGetMealPlan\x12\x17.api.GetMealPlanRequest\x1a\x15.api.MealPlanResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/meal-plan/household/{household_id}\x12q\n" +
+	"\x13UpdateMealPlanEntry\x12\x1f.api.UpdateMealPlanEntryRequest\x1a\x1a.api.MealPlanEntryResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/meal-plan/{id}\x12g\n" +
+	"\x13DeleteMealPlanEntry\x12\x1c.api.GetMealPlanEntryRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/meal-plan/{id}2\xd9\a\n" +
+	"\x13ShoppingListService\x12n\n" +
+	"\x12CreateShoppingList\x12\x1e.api.CreateShoppingListRequest\x1a\x19.api.ShoppingListResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/shopping-lists\x12j\n" +
+	"\x0fGetShoppingList\x12\x1b.api.GetShoppingListRequest\x1a\x19.api.ShoppingListResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/shopping-lists/{id}\x12\x81\x01\n" +
+	"\x10GetShoppingLists\x12\x1c.api.GetShoppingListsRequest\x1a\x1a.api.ShoppingListsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/shopping-lists/household/{household_id}\x12y\n" +
+	"\x13RefreshShoppingList\x12\x1b.api.GetShoppingListRequest\x1a\x19.api.ShoppingListResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/shopping-lists/{id}:refresh\x12j\n" +
+	"\x12DeleteShoppingList\x12\x1b.api.GetShoppingListRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v1/shopping-lists/{id}\x12x\n" +
+	"\x0fAddShoppingItem\x12\x1b.api.AddShoppingItemRequest\x1a\x19.api.ShoppingListResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/shopping-lists/{list_id}/items\x12\x81\x01\n" +
+	"\x11CheckShoppingItem\x12\x1d.api.CheckShoppingItemRequest\x1a\x19.api.ShoppingListResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/v1/shopping-lists/{list_id}/items/{id}\x12}\n" +
+	"\x12DeleteShoppingItem\x12\x1e.api.DeleteShoppingItemRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)*'/v1/shopping-lists/{list_id}/items/{id}2\xeb\x06\n" +
 	"\fEventService\x12Q\n" +
 	"\vCreateEvent\x12\x17.api.CreateEventRequest\x1a\x12.api.EventResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
 	"/v1/events\x12M\n" +
@@ -1930,7 +5242,11 @@ const file_hmly_proto_rawDesc = "" +
 	"\tGetEvents\x12\x15.api.GetEventsRequest\x1a\x13.api.EventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/events\x12V\n" +
 	"\vUpdateEvent\x12\x17.api.UpdateEventRequest\x1a\x12.api.EventResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v1/events/{id}\x12T\n" +
-	"\vDeleteEvent\x12\x14.api.GetEventRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/events/{id}B!Z\x1fgithub.com/hmlylab/common/protob\x06proto3"
+	"\vDeleteEvent\x12\x14.api.GetEventRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v1/events/{id}\x12\\\n" +
+	"\x0fListOccurrences\x12\x1b.api.ListOccurrencesRequest\x1a\x13.api.EventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/occurrences\x12{\n" +
+	"\fImportEvents\x12\x18.api.ImportEventsRequest\x1a\x19.api.ImportEventsResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/households/{household_id}/events:import\x12t\n" +
+	"\x0eAssignRotation\x12\x1a.api.AssignRotationRequest\x1a\x1b.api.AssignRotationResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/{event_id}/rotation\x12m\n" +
+	"\vGetWorkload\x12\x17.api.GetWorkloadRequest\x1a\x15.api.WorkloadResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/households/{household_id}/workloadB!Z\x1fgithub.com/hmlylab/common/protob\x06proto3"
 
 var (
 	file_hmly_proto_rawDescOnce sync.Once
@@ -1944,98 +5260,211 @@ func file_hmly_proto_rawDescGZIP() []byte {
 	return file_hmly_proto_rawDescData
 }
 
-var file_hmly_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_hmly_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_hmly_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_hmly_proto_goTypes = []any{
-	(*CreateHouseholdRequest)(nil),  // 0: api.CreateHouseholdRequest
-	(*GetHouseholdRequest)(nil),     // 1: api.GetHouseholdRequest
-	(*GetHouseHoldsRequest)(nil),    // 2: api.GetHouseHoldsRequest
-	(*UpdateHouseholdRequest)(nil),  // 3: api.UpdateHouseholdRequest
-	(*CreateHouseholdResponse)(nil), // 4: api.CreateHouseholdResponse
-	(*HouseholdResponse)(nil),       // 5: api.HouseholdResponse
-	(*Error)(nil),                   // 6: api.Error
-	(*HouseholdsResponse)(nil),      // 7: api.HouseholdsResponse
-	(*CreateMemberRequest)(nil),     // 8: api.CreateMemberRequest
-	(*GetMemberRequest)(nil),        // 9: api.GetMemberRequest
-	(*GetMembersRequest)(nil),       // 10: api.GetMembersRequest
-	(*UpdateMemberRequest)(nil),     // 11: api.UpdateMemberRequest
-	(*MemberResponse)(nil),          // 12: api.MemberResponse
-	(*MembersResponse)(nil),         // 13: api.MembersResponse
-	(*CreateMealRequest)(nil),       // 14: api.CreateMealRequest
-	(*GetMealRequest)(nil),          // 15: api.GetMealRequest
-	(*GetMealsRequest)(nil),         // 16: api.GetMealsRequest
-	(*UpdateMealRequest)(nil),       // 17: api.UpdateMealRequest
-	(*MealResponse)(nil),            // 18: api.MealResponse
-	(*MealsResponse)(nil),           // 19: api.MealsResponse
-	(*CreateEventRequest)(nil),      // 20: api.CreateEventRequest
-	(*GetEventRequest)(nil),         // 21: api.GetEventRequest
-	(*GetEventsRequest)(nil),        // 22: api.GetEventsRequest
-	(*UpdateEventRequest)(nil),      // 23: api.UpdateEventRequest
-	(*EventResponse)(nil),           // 24: api.EventResponse
-	(*EventsResponse)(nil),          // 25: api.EventsResponse
-	(*VerifyTokenRequest)(nil),      // 26: api.VerifyTokenRequest
-	(*VerifyTokenResponse)(nil),     // 27: api.VerifyTokenResponse
-	(*emptypb.Empty)(nil),           // 28: google.protobuf.Empty
+	(MealSlot)(0),                      // 0: api.MealSlot
+	(EditScope)(0),                     // 1: api.EditScope
+	(RotationStrategy)(0),              // 2: api.RotationStrategy
+	(*CreateHouseholdRequest)(nil),     // 3: api.CreateHouseholdRequest
+	(*GetHouseholdRequest)(nil),        // 4: api.GetHouseholdRequest
+	(*GetHouseHoldsRequest)(nil),       // 5: api.GetHouseHoldsRequest
+	(*UpdateHouseholdRequest)(nil),     // 6: api.UpdateHouseholdRequest
+	(*CreateHouseholdResponse)(nil),    // 7: api.CreateHouseholdResponse
+	(*HouseholdResponse)(nil),          // 8: api.HouseholdResponse
+	(*Error)(nil),                      // 9: api.Error
+	(*HouseholdsResponse)(nil),         // 10: api.HouseholdsResponse
+	(*CreateMemberRequest)(nil),        // 11: api.CreateMemberRequest
+	(*GetMemberRequest)(nil),           // 12: api.GetMemberRequest
+	(*GetMembersRequest)(nil),          // 13: api.GetMembersRequest
+	(*UpdateMemberRequest)(nil),        // 14: api.UpdateMemberRequest
+	(*MemberResponse)(nil),             // 15: api.MemberResponse
+	(*MembersResponse)(nil),            // 16: api.MembersResponse
+	(*CreateMealRequest)(nil),          // 17: api.CreateMealRequest
+	(*GetMealRequest)(nil),             // 18: api.GetMealRequest
+	(*GetMealsRequest)(nil),            // 19: api.GetMealsRequest
+	(*UpdateMealRequest)(nil),          // 20: api.UpdateMealRequest
+	(*MealResponse)(nil),               // 21: api.MealResponse
+	(*MealsResponse)(nil),              // 22: api.MealsResponse
+	(*Ingredient)(nil),                 // 23: api.Ingredient
+	(*CreateRecipeRequest)(nil),        // 24: api.CreateRecipeRequest
+	(*GetRecipeRequest)(nil),           // 25: api.GetRecipeRequest
+	(*GetRecipesRequest)(nil),          // 26: api.GetRecipesRequest
+	(*UpdateRecipeRequest)(nil),        // 27: api.UpdateRecipeRequest
+	(*RecipeResponse)(nil),             // 28: api.RecipeResponse
+	(*RecipesResponse)(nil),            // 29: api.RecipesResponse
+	(*CreateMealPlanEntryRequest)(nil), // 30: api.CreateMealPlanEntryRequest
+	(*GetMealPlanEntryRequest)(nil),    // 31: api.GetMealPlanEntryRequest
+	(*GetMealPlanRequest)(nil),         // 32: api.GetMealPlanRequest
+	(*UpdateMealPlanEntryRequest)(nil), // 33: api.UpdateMealPlanEntryRequest
+	(*MealPlanEntryResponse)(nil),      // 34: api.MealPlanEntryResponse
+	(*MealPlanResponse)(nil),           // 35: api.MealPlanResponse
+	(*ShoppingItem)(nil),               // 36: api.ShoppingItem
+	(*CreateShoppingListRequest)(nil),  // 37: api.CreateShoppingListRequest
+	(*GetShoppingListRequest)(nil),     // 38: api.GetShoppingListRequest
+	(*GetShoppingListsRequest)(nil),    // 39: api.GetShoppingListsRequest
+	(*AddShoppingItemRequest)(nil),     // 40: api.AddShoppingItemRequest
+	(*CheckShoppingItemRequest)(nil),   // 41: api.CheckShoppingItemRequest
+	(*DeleteShoppingItemRequest)(nil),  // 42: api.DeleteShoppingItemRequest
+	(*ShoppingListResponse)(nil),       // 43: api.ShoppingListResponse
+	(*ShoppingListsResponse)(nil),      // 44: api.ShoppingListsResponse
+	(*CreateEventRequest)(nil),         // 45: api.CreateEventRequest
+	(*GetEventRequest)(nil),            // 46: api.GetEventRequest
+	(*GetEventsRequest)(nil),           // 47: api.GetEventsRequest
+	(*UpdateEventRequest)(nil),         // 48: api.UpdateEventRequest
+	(*EventResponse)(nil),              // 49: api.EventResponse
+	(*ListOccurrencesRequest)(nil),     // 50: api.ListOccurrencesRequest
+	(*EventsResponse)(nil),             // 51: api.EventsResponse
+	(*ImportEventsRequest)(nil),        // 52: api.ImportEventsRequest
+	(*ImportedEvent)(nil),              // 53: api.ImportedEvent
+	(*ImportProblem)(nil),              // 54: api.ImportProblem
+	(*ImportEventsResponse)(nil),       // 55: api.ImportEventsResponse
+	(*Unavailability)(nil),             // 56: api.Unavailability
+	(*RotationMember)(nil),             // 57: api.RotationMember
+	(*AssignRotationRequest)(nil),      // 58: api.AssignRotationRequest
+	(*RotationAssignment)(nil),         // 59: api.RotationAssignment
+	(*AssignRotationResponse)(nil),     // 60: api.AssignRotationResponse
+	(*GetWorkloadRequest)(nil),         // 61: api.GetWorkloadRequest
+	(*MemberWorkload)(nil),             // 62: api.MemberWorkload
+	(*WorkloadResponse)(nil),           // 63: api.WorkloadResponse
+	(*VerifyTokenRequest)(nil),         // 64: api.VerifyTokenRequest
+	(*VerifyTokenResponse)(nil),        // 65: api.VerifyTokenResponse
+	(*emptypb.Empty)(nil),              // 66: google.protobuf.Empty
 }
 var file_hmly_proto_depIdxs = []int32{
-	6,  // 0: api.CreateHouseholdResponse.error_message:type_name -> api.Error
-	6,  // 1: api.HouseholdResponse.error_message:type_name -> api.Error
-	5,  // 2: api.HouseholdsResponse.households:type_name -> api.HouseholdResponse
-	6,  // 3: api.HouseholdsResponse.error_message:type_name -> api.Error
-	6,  // 4: api.MemberResponse.error_message:type_name -> api.Error
-	12, // 5: api.MembersResponse.members:type_name -> api.MemberResponse
-	6,  // 6: api.MembersResponse.error_message:type_name -> api.Error
-	6,  // 7: api.MealResponse.error_message:type_name -> api.Error
-	18, // 8: api.MealsResponse.meals:type_name -> api.MealResponse
-	6,  // 9: api.MealsResponse.error_message:type_name -> api.Error
-	6,  // 10: api.EventResponse.error_message:type_name -> api.Error
-	24, // 11: api.EventsResponse.events:type_name -> api.EventResponse
-	6,  // 12: api.EventsResponse.error_message:type_name -> api.Error
-	6,  // 13: api.VerifyTokenResponse.error_message:type_name -> api.Error
-	0,  // 14: api.HouseholdService.CreateHousehold:input_type -> api.CreateHouseholdRequest
-	1,  // 15: api.HouseholdService.GetHousehold:input_type -> api.GetHouseholdRequest
-	2,  // 16: api.HouseholdService.GetHouseholds:input_type -> api.GetHouseHoldsRequest
-	3,  // 17: api.HouseholdService.UpdateHousehold:input_type -> api.UpdateHouseholdRequest
-	1,  // 18: api.HouseholdService.DeleteHousehold:input_type -> api.GetHouseholdRequest
-	8,  // 19: api.MemberService.CreateMember:input_type -> api.CreateMemberRequest
-	9,  // 20: api.MemberService.GetMember:input_type -> api.GetMemberRequest
-	10, // 21: api.MemberService.GetMembers:input_type -> api.GetMembersRequest
-	11, // 22: api.MemberService.UpdateMember:input_type -> api.UpdateMemberRequest
-	9,  // 23: api.MemberService.DeleteMember:input_type -> api.GetMemberRequest
-	14, // 24: api.MealService.CreateMeal:input_type -> api.CreateMealRequest
-	15, // 25: api.MealService.GetMeal:input_type -> api.GetMealRequest
-	16, // 26: api.MealService.GetMeals:input_type -> api.GetMealsRequest
-	17, // 27: api.MealService.UpdateMeal:input_type -> api.UpdateMealRequest
-	15, // 28: api.MealService.DeleteMeal:input_type -> api.GetMealRequest
-	20, // 29: api.EventService.CreateEvent:input_type -> api.CreateEventRequest
-	21, // 30: api.EventService.GetEvent:input_type -> api.GetEventRequest
-	22, // 31: api.EventService.GetEvents:input_type -> api.GetEventsRequest
-	23, // 32: api.EventService.UpdateEvent:input_type -> api.UpdateEventRequest
-	21, // 33: api.EventService.DeleteEvent:input_type -> api.GetEventRequest
-	5,  // 34: api.HouseholdService.CreateHousehold:output_type -> api.HouseholdResponse
-	5,  // 35: api.HouseholdService.GetHousehold:output_type -> api.HouseholdResponse
-	7,  // 36: api.HouseholdService.GetHouseholds:output_type -> api.HouseholdsResponse
-	5,  // 37: api.HouseholdService.UpdateHousehold:output_type -> api.HouseholdResponse
-	28, // 38: api.HouseholdService.DeleteHousehold:output_type -> google.protobuf.Empty
-	12, // 39: api.MemberService.CreateMember:output_type -> api.MemberResponse
-	12, // 40: api.MemberService.GetMember:output_type -> api.MemberResponse
-	13, // 41: api.MemberService.GetMembers:output_type -> api.MembersResponse
-	12, // 42: api.MemberService.UpdateMember:output_type -> api.MemberResponse
-	28, // 43: api.MemberService.DeleteMember:output_type -> google.protobuf.Empty
-	18, // 44: api.MealService.CreateMeal:output_type -> api.MealResponse
-	18, // 45: api.MealService.GetMeal:output_type -> api.MealResponse
-	19, // 46: api.MealService.GetMeals:output_type -> api.MealsResponse
-	18, // 47: api.MealService.UpdateMeal:output_type -> api.MealResponse
-	28, // 48: api.MealService.DeleteMeal:output_type -> google.protobuf.Empty
-	24, // 49: api.EventService.CreateEvent:output_type -> api.EventResponse
-	24, // 50: api.EventService.GetEvent:output_type -> api.EventResponse
-	25, // 51: api.EventService.GetEvents:output_type -> api.EventsResponse
-	24, // 52: api.EventService.UpdateEvent:output_type -> api.EventResponse
-	28, // 53: api.EventService.DeleteEvent:output_type -> google.protobuf.Empty
-	34, // [34:54] is the sub-list for method output_type
-	14, // [14:34] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	9,  // 0: api.CreateHouseholdResponse.error_message:type_name -> api.Error
+	9,  // 1: api.HouseholdResponse.error_message:type_name -> api.Error
+	8,  // 2: api.HouseholdsResponse.households:type_name -> api.HouseholdResponse
+	9,  // 3: api.HouseholdsResponse.error_message:type_name -> api.Error
+	9,  // 4: api.MemberResponse.error_message:type_name -> api.Error
+	15, // 5: api.MembersResponse.members:type_name -> api.MemberResponse
+	9,  // 6: api.MembersResponse.error_message:type_name -> api.Error
+	9,  // 7: api.MealResponse.error_message:type_name -> api.Error
+	21, // 8: api.MealsResponse.meals:type_name -> api.MealResponse
+	9,  // 9: api.MealsResponse.error_message:type_name -> api.Error
+	23, // 10: api.CreateRecipeRequest.ingredients:type_name -> api.Ingredient
+	23, // 11: api.UpdateRecipeRequest.ingredients:type_name -> api.Ingredient
+	23, // 12: api.RecipeResponse.ingredients:type_name -> api.Ingredient
+	9,  // 13: api.RecipeResponse.error_message:type_name -> api.Error
+	28, // 14: api.RecipesResponse.recipes:type_name -> api.RecipeResponse
+	9,  // 15: api.RecipesResponse.error_message:type_name -> api.Error
+	0,  // 16: api.CreateMealPlanEntryRequest.slot:type_name -> api.MealSlot
+	0,  // 17: api.GetMealPlanRequest.slot:type_name -> api.MealSlot
+	0,  // 18: api.UpdateMealPlanEntryRequest.slot:type_name -> api.MealSlot
+	0,  // 19: api.MealPlanEntryResponse.slot:type_name -> api.MealSlot
+	28, // 20: api.MealPlanEntryResponse.recipe:type_name -> api.RecipeResponse
+	9,  // 21: api.MealPlanEntryResponse.error_message:type_name -> api.Error
+	34, // 22: api.MealPlanResponse.entries:type_name -> api.MealPlanEntryResponse
+	9,  // 23: api.MealPlanResponse.error_message:type_name -> api.Error
+	36, // 24: api.ShoppingListResponse.items:type_name -> api.ShoppingItem
+	9,  // 25: api.ShoppingListResponse.error_message:type_name -> api.Error
+	43, // 26: api.ShoppingListsResponse.lists:type_name -> api.ShoppingListResponse
+	9,  // 27: api.ShoppingListsResponse.error_message:type_name -> api.Error
+	1,  // 28: api.UpdateEventRequest.scope:type_name -> api.EditScope
+	9,  // 29: api.EventResponse.error_message:type_name -> api.Error
+	49, // 30: api.EventsResponse.events:type_name -> api.EventResponse
+	9,  // 31: api.EventsResponse.error_message:type_name -> api.Error
+	49, // 32: api.ImportedEvent.event:type_name -> api.EventResponse
+	53, // 33: api.ImportEventsResponse.events:type_name -> api.ImportedEvent
+	54, // 34: api.ImportEventsResponse.problems:type_name -> api.ImportProblem
+	9,  // 35: api.ImportEventsResponse.error_message:type_name -> api.Error
+	56, // 36: api.RotationMember.unavailable:type_name -> api.Unavailability
+	2,  // 37: api.AssignRotationRequest.strategy:type_name -> api.RotationStrategy
+	57, // 38: api.AssignRotationRequest.members:type_name -> api.RotationMember
+	59, // 39: api.AssignRotationResponse.assignments:type_name -> api.RotationAssignment
+	9,  // 40: api.AssignRotationResponse.error_message:type_name -> api.Error
+	62, // 41: api.WorkloadResponse.workloads:type_name -> api.MemberWorkload
+	9,  // 42: api.WorkloadResponse.error_message:type_name -> api.Error
+	9,  // 43: api.VerifyTokenResponse.error_message:type_name -> api.Error
+	3,  // 44: api.HouseholdService.CreateHousehold:input_type -> api.CreateHouseholdRequest
+	4,  // 45: api.HouseholdService.GetHousehold:input_type -> api.GetHouseholdRequest
+	5,  // 46: api.HouseholdService.GetHouseholds:input_type -> api.GetHouseHoldsRequest
+	6,  // 47: api.HouseholdService.UpdateHousehold:input_type -> api.UpdateHouseholdRequest
+	4,  // 48: api.HouseholdService.DeleteHousehold:input_type -> api.GetHouseholdRequest
+	11, // 49: api.MemberService.CreateMember:input_type -> api.CreateMemberRequest
+	12, // 50: api.MemberService.GetMember:input_type -> api.GetMemberRequest
+	13, // 51: api.MemberService.GetMembers:input_type -> api.GetMembersRequest
+	14, // 52: api.MemberService.UpdateMember:input_type -> api.UpdateMemberRequest
+	12, // 53: api.MemberService.DeleteMember:input_type -> api.GetMemberRequest
+	17, // 54: api.MealService.CreateMeal:input_type -> api.CreateMealRequest
+	18, // 55: api.MealService.GetMeal:input_type -> api.GetMealRequest
+	19, // 56: api.MealService.GetMeals:input_type -> api.GetMealsRequest
+	20, // 57: api.MealService.UpdateMeal:input_type -> api.UpdateMealRequest
+	18, // 58: api.MealService.DeleteMeal:input_type -> api.GetMealRequest
+	24, // 59: api.MealService.CreateRecipe:input_type -> api.CreateRecipeRequest
+	25, // 60: api.MealService.GetRecipe:input_type -> api.GetRecipeRequest
+	26, // 61: api.MealService.GetRecipes:input_type -> api.GetRecipesRequest
+	27, // 62: api.MealService.UpdateRecipe:input_type -> api.UpdateRecipeRequest
+	25, // 63: api.MealService.DeleteRecipe:input_type -> api.GetRecipeRequest
+	30, // 64: api.MealService.CreateMealPlanEntry:input_type -> api.CreateMealPlanEntryRequest
+	31, // 65: api.MealService.GetMealPlanEntry:input_type -> api.GetMealPlanEntryRequest
+	32, // 66: api.MealService.GetMealPlan:input_type -> api.GetMealPlanRequest
+	33, // 67: api.MealService.UpdateMealPlanEntry:input_type -> api.UpdateMealPlanEntryRequest
+	31, // 68: api.MealService.DeleteMealPlanEntry:input_type -> api.GetMealPlanEntryRequest
+	37, // 69: api.ShoppingListService.CreateShoppingList:input_type -> api.CreateShoppingListRequest
+	38, // 70: api.ShoppingListService.GetShoppingList:input_type -> api.GetShoppingListRequest
+	39, // 71: api.ShoppingListService.GetShoppingLists:input_type -> api.GetShoppingListsRequest
+	38, // 72: api.ShoppingListService.RefreshShoppingList:input_type -> api.GetShoppingListRequest
+	38, // 73: api.ShoppingListService.DeleteShoppingList:input_type -> api.GetShoppingListRequest
+	40, // 74: api.ShoppingListService.AddShoppingItem:input_type -> api.AddShoppingItemRequest
+	41, // 75: api.ShoppingListService.CheckShoppingItem:input_type -> api.CheckShoppingItemRequest
+	42, // 76: api.ShoppingListService.DeleteShoppingItem:input_type -> api.DeleteShoppingItemRequest
+	45, // 77: api.EventService.CreateEvent:input_type -> api.CreateEventRequest
+	46, // 78: api.EventService.GetEvent:input_type -> api.GetEventRequest
+	47, // 79: api.EventService.GetEvents:input_type -> api.GetEventsRequest
+	48, // 80: api.EventService.UpdateEvent:input_type -> api.UpdateEventRequest
+	46, // 81: api.EventService.DeleteEvent:input_type -> api.GetEventRequest
+	50, // 82: api.EventService.ListOccurrences:input_type -> api.ListOccurrencesRequest
+	52, // 83: api.EventService.ImportEvents:input_type -> api.ImportEventsRequest
+	58, // 84: api.EventService.AssignRotation:input_type -> api.AssignRotationRequest
+	61, // 85: api.EventService.GetWorkload:input_type -> api.GetWorkloadRequest
+	8,  // 86: api.HouseholdService.CreateHousehold:output_type -> api.HouseholdResponse
+	8,  // 87: api.HouseholdService.GetHousehold:output_type -> api.HouseholdResponse
+	10, // 88: api.HouseholdService.GetHouseholds:output_type -> api.HouseholdsResponse
+	8,  // 89: api.HouseholdService.UpdateHousehold:output_type -> api.HouseholdResponse
+	66, // 90: api.HouseholdService.DeleteHousehold:output_type -> google.protobuf.Empty
+	15, // 91: api.MemberService.CreateMember:output_type -> api.MemberResponse
+	15, // 92: api.MemberService.GetMember:output_type -> api.MemberResponse
+	16, // 93: api.MemberService.GetMembers:output_type -> api.MembersResponse
+	15, // 94: api.MemberService.UpdateMember:output_type -> api.MemberResponse
+	66, // 95: api.MemberService.DeleteMember:output_type -> google.protobuf.Empty
+	21, // 96: api.MealService.CreateMeal:output_type -> api.MealResponse
+	21, // 97: api.MealService.GetMeal:output_type -> api.MealResponse
+	22, // 98: api.MealService.GetMeals:output_type -> api.MealsResponse
+	21, // 99: api.MealService.UpdateMeal:output_type -> api.MealResponse
+	66, // 100: api.MealService.DeleteMeal:output_type -> google.protobuf.Empty
+	28, // 101: api.MealService.CreateRecipe:output_type -> api.RecipeResponse
+	28, // 102: api.MealService.GetRecipe:output_type -> api.RecipeResponse
+	29, // 103: api.MealService.GetRecipes:output_type -> api.RecipesResponse
+	28, // 104: api.MealService.UpdateRecipe:output_type -> api.RecipeResponse
+	66, // 105: api.MealService.DeleteRecipe:output_type -> google.protobuf.Empty
+	34, // 106: api.MealService.CreateMealPlanEntry:output_type -> api.MealPlanEntryResponse
+	34, // 107: api.MealService.GetMealPlanEntry:output_type -> api.MealPlanEntryResponse
+	35, // 108: api.MealService.GetMealPlan:output_type -> api.MealPlanResponse
+	34, // 109: api.MealService.UpdateMealPlanEntry:output_type -> api.MealPlanEntryResponse
+	66, // 110: api.MealService.DeleteMealPlanEntry:output_type -> google.protobuf.Empty
+	43, // 111: api.ShoppingListService.CreateShoppingList:output_type -> api.ShoppingListResponse
+	43, // 112: api.ShoppingListService.GetShoppingList:output_type -> api.ShoppingListResponse
+	44, // 113: api.ShoppingListService.GetShoppingLists:output_type -> api.ShoppingListsResponse
+	43, // 114: api.ShoppingListService.RefreshShoppingList:output_type -> api.ShoppingListResponse
+	66, // 115: api.ShoppingListService.DeleteShoppingList:output_type -> google.protobuf.Empty
+	43, // 116: api.ShoppingListService.AddShoppingItem:output_type -> api.ShoppingListResponse
+	43, // 117: api.ShoppingListService.CheckShoppingItem:output_type -> api.ShoppingListResponse
+	66, // 118: api.ShoppingListService.DeleteShoppingItem:output_type -> google.protobuf.Empty
+	49, // 119: api.EventService.CreateEvent:output_type -> api.EventResponse
+	49, // 120: api.EventService.GetEvent:output_type -> api.EventResponse
+	51, // 121: api.EventService.GetEvents:output_type -> api.EventsResponse
+	49, // 122: api.EventService.UpdateEvent:output_type -> api.EventResponse
+	66, // 123: api.EventService.DeleteEvent:output_type -> google.protobuf.Empty
+	51, // 124: api.EventService.ListOccurrences:output_type -> api.EventsResponse
+	55, // 125: api.EventService.ImportEvents:output_type -> api.ImportEventsResponse
+	60, // 126: api.EventService.AssignRotation:output_type -> api.AssignRotationResponse
+	63, // 127: api.EventService.GetWorkload:output_type -> api.WorkloadResponse
+	86, // [86:128] is the sub-list for method output_type
+	44, // [44:86] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_hmly_proto_init() }
@@ -2043,6 +5472,8 @@ func file_hmly_proto_init() {
 	if File_hmly_proto != nil {
 		return
 	}
+	file_hmly_proto_msgTypes[0].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[3].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[4].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[5].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[7].OneofWrappers = []any{}
@@ -2050,22 +5481,39 @@ func file_hmly_proto_init() {
 	file_hmly_proto_msgTypes[13].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[18].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[19].OneofWrappers = []any{}
-	file_hmly_proto_msgTypes[22].OneofWrappers = []any{}
-	file_hmly_proto_msgTypes[24].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[23].OneofWrappers = []any{}
 	file_hmly_proto_msgTypes[25].OneofWrappers = []any{}
-	file_hmly_proto_msgTypes[27].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[26].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[29].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[31].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[32].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[36].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[40].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[41].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[44].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[46].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[47].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[48].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[49].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[52].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[55].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[57].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[58].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[60].OneofWrappers = []any{}
+	file_hmly_proto_msgTypes[62].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hmly_proto_rawDesc), len(file_hmly_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   28,
+			NumEnums:      3,
+			NumMessages:   63,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   5,
 		},
 		GoTypes:           file_hmly_proto_goTypes,
 		DependencyIndexes: file_hmly_proto_depIdxs,
+		EnumInfos:         file_hmly_proto_enumTypes,
 		MessageInfos:      file_hmly_proto_msgTypes,
 	}.Build()
 	File_hmly_proto = out.File
@@ -586,11 +586,21 @@ var MemberService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	MealService_CreateMeal_FullMethodName = "/api.MealService/CreateMeal"
-	MealService_GetMeal_FullMethodName    = "/api.MealService/GetMeal"
-	MealService_GetMeals_FullMethodName   = "/api.MealService/GetMeals"
-	MealService_UpdateMeal_FullMethodName = "/api.MealService/UpdateMeal"
-	MealService_DeleteMeal_FullMethodName = "/api.MealService/DeleteMeal"
+	MealService_CreateMeal_FullMethodName          = "/api.MealService/CreateMeal"
+	MealService_GetMeal_FullMethodName             = "/api.MealService/GetMeal"
+	MealService_GetMeals_FullMethodName            = "/api.MealService/GetMeals"
+	MealService_UpdateMeal_FullMethodName          = "/api.MealService/UpdateMeal"
+	MealService_DeleteMeal_FullMethodName          = "/api.MealService/DeleteMeal"
+	MealService_CreateRecipe_FullMethodName        = "/api.MealService/CreateRecipe"
+	MealService_GetRecipe_FullMethodName           = "/api.MealService/GetRecipe"
+	MealService_GetRecipes_FullMethodName          = "/api.MealService/GetRecipes"
+	MealService_UpdateRecipe_FullMethodName        = "/api.MealService/UpdateRecipe"
+	MealService_DeleteRecipe_FullMethodName        = "/api.MealService/DeleteRecipe"
+	MealService_CreateMealPlanEntry_FullMethodName = "/api.MealService/CreateMealPlanEntry"
+	MealService_GetMealPlanEntry_FullMethodName    = "/api.MealService/GetMealPlanEntry"
+	MealService_GetMealPlan_FullMethodName         = "/api.MealService/GetMealPlan"
+	MealService_UpdateMealPlanEntry_FullMethodName = "/api.MealService/UpdateMealPlanEntry"
+	MealService_DeleteMealPlanEntry_FullMethodName = "/api.MealService/DeleteMealPlanEntry"
 )
 
 // MealServiceClient is the client API for MealService service.
@@ -615,6 +625,26 @@ type MealServiceClient interface {
 	// DeleteMeal removes a meal from the household's meal plan.
 	// Permanently deletes the meal record from the system.
 	DeleteMeal(ctx context.Context, in *GetMealRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateRecipe adds a recipe, with its ingredients, to a household.
+	CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	// GetRecipe retrieves a specific recipe with its ingredients.
+	GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	// GetRecipes lists a household's recipes, optionally by tag or name.
+	GetRecipes(ctx context.Context, in *GetRecipesRequest, opts ...grpc.CallOption) (*RecipesResponse, error)
+	// UpdateRecipe replaces a recipe and its ingredients.
+	UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error)
+	// DeleteRecipe removes a recipe that no plan entries use.
+	DeleteRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateMealPlanEntry plans a recipe for a meal of a day.
+	CreateMealPlanEntry(ctx context.Context, in *CreateMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error)
+	// GetMealPlanEntry retrieves a specific plan entry with its recipe.
+	GetMealPlanEntry(ctx context.Context, in *GetMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error)
+	// GetMealPlan lists a household's planned meals over a range of days.
+	GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error)
+	// UpdateMealPlanEntry replaces a plan entry.
+	UpdateMealPlanEntry(ctx context.Context, in *UpdateMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error)
+	// DeleteMealPlanEntry removes a planned meal.
+	DeleteMealPlanEntry(ctx context.Context, in *GetMealPlanEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type mealServiceClient struct {
@@ -675,6 +705,106 @@ func (c *mealServiceClient) DeleteMeal(ctx context.Context, in *GetMealRequest,
 	return out, nil
 }
 
+func (c *mealServiceClient) CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, MealService_CreateRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) GetRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, MealService_GetRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) GetRecipes(ctx context.Context, in *GetRecipesRequest, opts ...grpc.CallOption) (*RecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipesResponse)
+	err := c.cc.Invoke(ctx, MealService_GetRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*RecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeResponse)
+	err := c.cc.Invoke(ctx, MealService_UpdateRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) DeleteRecipe(ctx context.Context, in *GetRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MealService_DeleteRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) CreateMealPlanEntry(ctx context.Context, in *CreateMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanEntryResponse)
+	err := c.cc.Invoke(ctx, MealService_CreateMealPlanEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) GetMealPlanEntry(ctx context.Context, in *GetMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanEntryResponse)
+	err := c.cc.Invoke(ctx, MealService_GetMealPlanEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*MealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanResponse)
+	err := c.cc.Invoke(ctx, MealService_GetMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) UpdateMealPlanEntry(ctx context.Context, in *UpdateMealPlanEntryRequest, opts ...grpc.CallOption) (*MealPlanEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MealPlanEntryResponse)
+	err := c.cc.Invoke(ctx, MealService_UpdateMealPlanEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealServiceClient) DeleteMealPlanEntry(ctx context.Context, in *GetMealPlanEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MealService_DeleteMealPlanEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MealServiceServer is the server API for MealService service.
 // All implementations must embed UnimplementedMealServiceServer
 // for forward compatibility.
@@ -697,168 +827,832 @@ type MealServiceServer interface {
 	// DeleteMeal removes a meal from the household's meal plan.
 	// Permanently deletes the meal record from the system.
 	DeleteMeal(context.Context, *GetMealRequest) (*emptypb.Empty, error)
+	// CreateRecipe adds a recipe, with its ingredients, to a household.
+	CreateRecipe(context.Context, *CreateRecipeRequest) (*RecipeResponse, error)
+	// GetRecipe retrieves a specific recipe with its ingredients.
+	GetRecipe(context.Context, *GetRecipeRequest) (*RecipeResponse, error)
+	// GetRecipes lists a household's recipes, optionally by tag or name.
+	GetRecipes(context.Context, *GetRecipesRequest) (*RecipesResponse, error)
+	// UpdateRecipe replaces a recipe and its ingredients.
+	UpdateRecipe(context.Context, *UpdateRecipeRequest) (*RecipeResponse, error)
+	// DeleteRecipe removes a recipe that no plan entries use.
+	DeleteRecipe(context.Context, *GetRecipeRequest) (*emptypb.Empty, error)
+	// CreateMealPlanEntry plans a recipe for a meal of a day.
+	CreateMealPlanEntry(context.Context, *CreateMealPlanEntryRequest) (*MealPlanEntryResponse, error)
+	// GetMealPlanEntry retrieves a specific plan entry with its recipe.
+	GetMealPlanEntry(context.Context, *GetMealPlanEntryRequest) (*MealPlanEntryResponse, error)
+	// GetMealPlan lists a household's planned meals over a range of days.
+	GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlanResponse, error)
+	// UpdateMealPlanEntry replaces a plan entry.
+	UpdateMealPlanEntry(context.Context, *UpdateMealPlanEntryRequest) (*MealPlanEntryResponse, error)
+	// DeleteMealPlanEntry removes a planned meal.
+	DeleteMealPlanEntry(context.Context, *GetMealPlanEntryRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMealServiceServer()
 }
 
-// UnimplementedMealServiceServer must be embedded to have
+// UnimplementedMealServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMealServiceServer struct{}
+
+func (UnimplementedMealServiceServer) CreateMeal(context.Context, *CreateMealRequest) (*MealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMeal not implemented")
+}
+func (UnimplementedMealServiceServer) GetMeal(context.Context, *GetMealRequest) (*MealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeal not implemented")
+}
+func (UnimplementedMealServiceServer) GetMeals(context.Context, *GetMealsRequest) (*MealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMeals not implemented")
+}
+func (UnimplementedMealServiceServer) UpdateMeal(context.Context, *UpdateMealRequest) (*MealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeal not implemented")
+}
+func (UnimplementedMealServiceServer) DeleteMeal(context.Context, *GetMealRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeal not implemented")
+}
+func (UnimplementedMealServiceServer) CreateRecipe(context.Context, *CreateRecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecipe not implemented")
+}
+func (UnimplementedMealServiceServer) GetRecipe(context.Context, *GetRecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipe not implemented")
+}
+func (UnimplementedMealServiceServer) GetRecipes(context.Context, *GetRecipesRequest) (*RecipesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipes not implemented")
+}
+func (UnimplementedMealServiceServer) UpdateRecipe(context.Context, *UpdateRecipeRequest) (*RecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRecipe not implemented")
+}
+func (UnimplementedMealServiceServer) DeleteRecipe(context.Context, *GetRecipeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecipe not implemented")
+}
+func (UnimplementedMealServiceServer) CreateMealPlanEntry(context.Context, *CreateMealPlanEntryRequest) (*MealPlanEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlanEntry not implemented")
+}
+func (UnimplementedMealServiceServer) GetMealPlanEntry(context.Context, *GetMealPlanEntryRequest) (*MealPlanEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanEntry not implemented")
+}
+func (UnimplementedMealServiceServer) GetMealPlan(context.Context, *GetMealPlanRequest) (*MealPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlan not implemented")
+}
+func (UnimplementedMealServiceServer) UpdateMealPlanEntry(context.Context, *UpdateMealPlanEntryRequest) (*MealPlanEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealPlanEntry not implemented")
+}
+func (UnimplementedMealServiceServer) DeleteMealPlanEntry(context.Context, *GetMealPlanEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMealPlanEntry not implemented")
+}
+func (UnimplementedMealServiceServer) mustEmbedUnimplementedMealServiceServer() {}
+func (UnimplementedMealServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeMealServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MealServiceServer will
+// result in compilation errors.
+type UnsafeMealServiceServer interface {
+	mustEmbedUnimplementedMealServiceServer()
+}
+
+func RegisterMealServiceServer(s grpc.ServiceRegistrar, srv MealServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMealServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MealService_ServiceDesc, srv)
+}
+
+func _MealService_CreateMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).CreateMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_CreateMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).CreateMeal(ctx, req.(*CreateMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetMeal(ctx, req.(*GetMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetMeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetMeals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetMeals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetMeals(ctx, req.(*GetMealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_UpdateMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).UpdateMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_UpdateMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).UpdateMeal(ctx, req.(*UpdateMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_DeleteMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).DeleteMeal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_DeleteMeal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).DeleteMeal(ctx, req.(*GetMealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).CreateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_CreateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).CreateRecipe(ctx, req.(*CreateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetRecipe(ctx, req.(*GetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetRecipes(ctx, req.(*GetRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).UpdateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_UpdateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).UpdateRecipe(ctx, req.(*UpdateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_DeleteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).DeleteRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_DeleteRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).DeleteRecipe(ctx, req.(*GetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_CreateMealPlanEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMealPlanEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).CreateMealPlanEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_CreateMealPlanEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).CreateMealPlanEntry(ctx, req.(*CreateMealPlanEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetMealPlanEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetMealPlanEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetMealPlanEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetMealPlanEntry(ctx, req.(*GetMealPlanEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_GetMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).GetMealPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_GetMealPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).GetMealPlan(ctx, req.(*GetMealPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_UpdateMealPlanEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMealPlanEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).UpdateMealPlanEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_UpdateMealPlanEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).UpdateMealPlanEntry(ctx, req.(*UpdateMealPlanEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealService_DeleteMealPlanEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealServiceServer).DeleteMealPlanEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealService_DeleteMealPlanEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealServiceServer).DeleteMealPlanEntry(ctx, req.(*GetMealPlanEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MealService_ServiceDesc is the grpc.ServiceDesc for MealService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MealService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.MealService",
+	HandlerType: (*MealServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMeal",
+			Handler:    _MealService_CreateMeal_Handler,
+		},
+		{
+			MethodName: "GetMeal",
+			Handler:    _MealService_GetMeal_Handler,
+		},
+		{
+			MethodName: "GetMeals",
+			Handler:    _MealService_GetMeals_Handler,
+		},
+		{
+			MethodName: "UpdateMeal",
+			Handler:    _MealService_UpdateMeal_Handler,
+		},
+		{
+			MethodName: "DeleteMeal",
+			Handler:    _MealService_DeleteMeal_Handler,
+		},
+		{
+			MethodName: "CreateRecipe",
+			Handler:    _MealService_CreateRecipe_Handler,
+		},
+		{
+			MethodName: "GetRecipe",
+			Handler:    _MealService_GetRecipe_Handler,
+		},
+		{
+			MethodName: "GetRecipes",
+			Handler:    _MealService_GetRecipes_Handler,
+		},
+		{
+			MethodName: "UpdateRecipe",
+			Handler:    _MealService_UpdateRecipe_Handler,
+		},
+		{
+			MethodName: "DeleteRecipe",
+			Handler:    _MealService_DeleteRecipe_Handler,
+		},
+		{
+			MethodName: "CreateMealPlanEntry",
+			Handler:    _MealService_CreateMealPlanEntry_Handler,
+		},
+		{
+			MethodName: "GetMealPlanEntry",
+			Handler:    _MealService_GetMealPlanEntry_Handler,
+		},
+		{
+			MethodName: "GetMealPlan",
+			Handler:    _MealService_GetMealPlan_Handler,
+		},
+		{
+			MethodName: "UpdateMealPlanEntry",
+			Handler:    _MealService_UpdateMealPlanEntry_Handler,
+		},
+		{
+			MethodName: "DeleteMealPlanEntry",
+			Handler:    _MealService_DeleteMealPlanEntry_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "hmly.proto",
+}
+
+const (
+	ShoppingListService_CreateShoppingList_FullMethodName  = "/api.ShoppingListService/CreateShoppingList"
+	ShoppingListService_GetShoppingList_FullMethodName     = "/api.ShoppingListService/GetShoppingList"
+	ShoppingListService_GetShoppingLists_FullMethodName    = "/api.ShoppingListService/GetShoppingLists"
+	ShoppingListService_RefreshShoppingList_FullMethodName = "/api.ShoppingListService/RefreshShoppingList"
+	ShoppingListService_DeleteShoppingList_FullMethodName  = "/api.ShoppingListService/DeleteShoppingList"
+	ShoppingListService_AddShoppingItem_FullMethodName     = "/api.ShoppingListService/AddShoppingItem"
+	ShoppingListService_CheckShoppingItem_FullMethodName   = "/api.ShoppingListService/CheckShoppingItem"
+	ShoppingListService_DeleteShoppingItem_FullMethodName  = "/api.ShoppingListService/DeleteShoppingItem"
+)
+
+// ShoppingListServiceClient is the client API for ShoppingListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ShoppingListService builds shopping lists from households' meal plans
+// and lets members add items and check them off.
+type ShoppingListServiceClient interface {
+	// CreateShoppingList builds a list of what the meals planned over a range
+	// of days need, adding up ingredients across recipes.
+	CreateShoppingList(ctx context.Context, in *CreateShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	// GetShoppingList retrieves a specific list with its items.
+	GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	// GetShoppingLists lists a household's shopping lists, latest first.
+	GetShoppingLists(ctx context.Context, in *GetShoppingListsRequest, opts ...grpc.CallOption) (*ShoppingListsResponse, error)
+	// RefreshShoppingList rebuilds a list from the current meal plan, keeping
+	// manual items and items already checked off.
+	RefreshShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	// DeleteShoppingList removes a list and its items.
+	DeleteShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AddShoppingItem adds something to a list by hand.
+	AddShoppingItem(ctx context.Context, in *AddShoppingItemRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	// CheckShoppingItem checks an item off, or back on.
+	CheckShoppingItem(ctx context.Context, in *CheckShoppingItemRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error)
+	// DeleteShoppingItem removes an item from a list.
+	DeleteShoppingItem(ctx context.Context, in *DeleteShoppingItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type shoppingListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShoppingListServiceClient(cc grpc.ClientConnInterface) ShoppingListServiceClient {
+	return &shoppingListServiceClient{cc}
+}
+
+func (c *shoppingListServiceClient) CreateShoppingList(ctx context.Context, in *CreateShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_CreateShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) GetShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_GetShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) GetShoppingLists(ctx context.Context, in *GetShoppingListsRequest, opts ...grpc.CallOption) (*ShoppingListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListsResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_GetShoppingLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) RefreshShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_RefreshShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) DeleteShoppingList(ctx context.Context, in *GetShoppingListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShoppingListService_DeleteShoppingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) AddShoppingItem(ctx context.Context, in *AddShoppingItemRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_AddShoppingItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) CheckShoppingItem(ctx context.Context, in *CheckShoppingItemRequest, opts ...grpc.CallOption) (*ShoppingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShoppingListResponse)
+	err := c.cc.Invoke(ctx, ShoppingListService_CheckShoppingItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shoppingListServiceClient) DeleteShoppingItem(ctx context.Context, in *DeleteShoppingItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShoppingListService_DeleteShoppingItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShoppingListServiceServer is the server API for ShoppingListService service.
+// All implementations must embed UnimplementedShoppingListServiceServer
+// for forward compatibility.
+//
+// ShoppingListService builds shopping lists from households' meal plans
+// and lets members add items and check them off.
+type ShoppingListServiceServer interface {
+	// CreateShoppingList builds a list of what the meals planned over a range
+	// of days need, adding up ingredients across recipes.
+	CreateShoppingList(context.Context, *CreateShoppingListRequest) (*ShoppingListResponse, error)
+	// GetShoppingList retrieves a specific list with its items.
+	GetShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error)
+	// GetShoppingLists lists a household's shopping lists, latest first.
+	GetShoppingLists(context.Context, *GetShoppingListsRequest) (*ShoppingListsResponse, error)
+	// RefreshShoppingList rebuilds a list from the current meal plan, keeping
+	// manual items and items already checked off.
+	RefreshShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error)
+	// DeleteShoppingList removes a list and its items.
+	DeleteShoppingList(context.Context, *GetShoppingListRequest) (*emptypb.Empty, error)
+	// AddShoppingItem adds something to a list by hand.
+	AddShoppingItem(context.Context, *AddShoppingItemRequest) (*ShoppingListResponse, error)
+	// CheckShoppingItem checks an item off, or back on.
+	CheckShoppingItem(context.Context, *CheckShoppingItemRequest) (*ShoppingListResponse, error)
+	// DeleteShoppingItem removes an item from a list.
+	DeleteShoppingItem(context.Context, *DeleteShoppingItemRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedShoppingListServiceServer()
+}
+
+// UnimplementedShoppingListServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedMealServiceServer struct{}
+type UnimplementedShoppingListServiceServer struct{}
 
-func (UnimplementedMealServiceServer) CreateMeal(context.Context, *CreateMealRequest) (*MealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateMeal not implemented")
+func (UnimplementedShoppingListServiceServer) CreateShoppingList(context.Context, *CreateShoppingListRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShoppingList not implemented")
 }
-func (UnimplementedMealServiceServer) GetMeal(context.Context, *GetMealRequest) (*MealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMeal not implemented")
+func (UnimplementedShoppingListServiceServer) GetShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShoppingList not implemented")
 }
-func (UnimplementedMealServiceServer) GetMeals(context.Context, *GetMealsRequest) (*MealsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMeals not implemented")
+func (UnimplementedShoppingListServiceServer) GetShoppingLists(context.Context, *GetShoppingListsRequest) (*ShoppingListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShoppingLists not implemented")
 }
-func (UnimplementedMealServiceServer) UpdateMeal(context.Context, *UpdateMealRequest) (*MealResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMeal not implemented")
+func (UnimplementedShoppingListServiceServer) RefreshShoppingList(context.Context, *GetShoppingListRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshShoppingList not implemented")
 }
-func (UnimplementedMealServiceServer) DeleteMeal(context.Context, *GetMealRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeal not implemented")
+func (UnimplementedShoppingListServiceServer) DeleteShoppingList(context.Context, *GetShoppingListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShoppingList not implemented")
 }
-func (UnimplementedMealServiceServer) mustEmbedUnimplementedMealServiceServer() {}
-func (UnimplementedMealServiceServer) testEmbeddedByValue()                     {}
+func (UnimplementedShoppingListServiceServer) AddShoppingItem(context.Context, *AddShoppingItemRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddShoppingItem not implemented")
+}
+func (UnimplementedShoppingListServiceServer) CheckShoppingItem(context.Context, *CheckShoppingItemRequest) (*ShoppingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckShoppingItem not implemented")
+}
+func (UnimplementedShoppingListServiceServer) DeleteShoppingItem(context.Context, *DeleteShoppingItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShoppingItem not implemented")
+}
+func (UnimplementedShoppingListServiceServer) mustEmbedUnimplementedShoppingListServiceServer() {}
+func (UnimplementedShoppingListServiceServer) testEmbeddedByValue()                             {}
 
-// UnsafeMealServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MealServiceServer will
+// UnsafeShoppingListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShoppingListServiceServer will
 // result in compilation errors.
-type UnsafeMealServiceServer interface {
-	mustEmbedUnimplementedMealServiceServer()
+type UnsafeShoppingListServiceServer interface {
+	mustEmbedUnimplementedShoppingListServiceServer()
 }
 
-func RegisterMealServiceServer(s grpc.ServiceRegistrar, srv MealServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMealServiceServer was
+func RegisterShoppingListServiceServer(s grpc.ServiceRegistrar, srv ShoppingListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedShoppingListServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&MealService_ServiceDesc, srv)
+	s.RegisterService(&ShoppingListService_ServiceDesc, srv)
 }
 
-func _MealService_CreateMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMealRequest)
+func _ShoppingListService_CreateShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShoppingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealServiceServer).CreateMeal(ctx, in)
+		return srv.(ShoppingListServiceServer).CreateShoppingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealService_CreateMeal_FullMethodName,
+		FullMethod: ShoppingListService_CreateShoppingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealServiceServer).CreateMeal(ctx, req.(*CreateMealRequest))
+		return srv.(ShoppingListServiceServer).CreateShoppingList(ctx, req.(*CreateShoppingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealService_GetMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealRequest)
+func _ShoppingListService_GetShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealServiceServer).GetMeal(ctx, in)
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealService_GetMeal_FullMethodName,
+		FullMethod: ShoppingListService_GetShoppingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealServiceServer).GetMeal(ctx, req.(*GetMealRequest))
+		return srv.(ShoppingListServiceServer).GetShoppingList(ctx, req.(*GetShoppingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealService_GetMeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealsRequest)
+func _ShoppingListService_GetShoppingLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealServiceServer).GetMeals(ctx, in)
+		return srv.(ShoppingListServiceServer).GetShoppingLists(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealService_GetMeals_FullMethodName,
+		FullMethod: ShoppingListService_GetShoppingLists_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealServiceServer).GetMeals(ctx, req.(*GetMealsRequest))
+		return srv.(ShoppingListServiceServer).GetShoppingLists(ctx, req.(*GetShoppingListsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealService_UpdateMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMealRequest)
+func _ShoppingListService_RefreshShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealServiceServer).UpdateMeal(ctx, in)
+		return srv.(ShoppingListServiceServer).RefreshShoppingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealService_UpdateMeal_FullMethodName,
+		FullMethod: ShoppingListService_RefreshShoppingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealServiceServer).UpdateMeal(ctx, req.(*UpdateMealRequest))
+		return srv.(ShoppingListServiceServer).RefreshShoppingList(ctx, req.(*GetShoppingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealService_DeleteMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealRequest)
+func _ShoppingListService_DeleteShoppingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShoppingListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealServiceServer).DeleteMeal(ctx, in)
+		return srv.(ShoppingListServiceServer).DeleteShoppingList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealService_DeleteMeal_FullMethodName,
+		FullMethod: ShoppingListService_DeleteShoppingList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealServiceServer).DeleteMeal(ctx, req.(*GetMealRequest))
+		return srv.(ShoppingListServiceServer).DeleteShoppingList(ctx, req.(*GetShoppingListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// MealService_ServiceDesc is the grpc.ServiceDesc for MealService service.
+func _ShoppingListService_AddShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).AddShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_AddShoppingItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).AddShoppingItem(ctx, req.(*AddShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_CheckShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).CheckShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_CheckShoppingItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).CheckShoppingItem(ctx, req.(*CheckShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShoppingListService_DeleteShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShoppingListServiceServer).DeleteShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShoppingListService_DeleteShoppingItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShoppingListServiceServer).DeleteShoppingItem(ctx, req.(*DeleteShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShoppingListService_ServiceDesc is the grpc.ServiceDesc for ShoppingListService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var MealService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "api.MealService",
-	HandlerType: (*MealServiceServer)(nil),
+var ShoppingListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.ShoppingListService",
+	HandlerType: (*ShoppingListServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateMeal",
-			Handler:    _MealService_CreateMeal_Handler,
+			MethodName: "CreateShoppingList",
+			Handler:    _ShoppingListService_CreateShoppingList_Handler,
 		},
 		{
-			MethodName: "GetMeal",
-			Handler:    _MealService_GetMeal_Handler,
+			MethodName: "GetShoppingList",
+			Handler:    _ShoppingListService_GetShoppingList_Handler,
 		},
 		{
-			MethodName: "GetMeals",
-			Handler:    _MealService_GetMeals_Handler,
+			MethodName: "GetShoppingLists",
+			Handler:    _ShoppingListService_GetShoppingLists_Handler,
 		},
 		{
-			MethodName: "UpdateMeal",
-			Handler:    _MealService_UpdateMeal_Handler,
+			MethodName: "RefreshShoppingList",
+			Handler:    _ShoppingListService_RefreshShoppingList_Handler,
 		},
 		{
-			MethodName: "DeleteMeal",
-			Handler:    _MealService_DeleteMeal_Handler,
+			MethodName: "DeleteShoppingList",
+			Handler:    _ShoppingListService_DeleteShoppingList_Handler,
+		},
+		{
+			MethodName: "AddShoppingItem",
+			Handler:    _ShoppingListService_AddShoppingItem_Handler,
+		},
+		{
+			MethodName: "CheckShoppingItem",
+			Handler:    _ShoppingListService_CheckShoppingItem_Handler,
+		},
+		{
+			MethodName: "DeleteShoppingItem",
+			Handler:    _ShoppingListService_DeleteShoppingItem_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -866,11 +1660,15 @@ var MealService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EventService_CreateEvent_FullMethodName = "/api.EventService/CreateEvent"
-	EventService_GetEvent_FullMethodName    = "/api.EventService/GetEvent"
-	EventService_GetEvents_FullMethodName   = "/api.EventService/GetEvents"
-	EventService_UpdateEvent_FullMethodName = "/api.EventService/UpdateEvent"
-	EventService_DeleteEvent_FullMethodName = "/api.EventService/DeleteEvent"
+	EventService_CreateEvent_FullMethodName     = "/api.EventService/CreateEvent"
+	EventService_GetEvent_FullMethodName        = "/api.EventService/GetEvent"
+	EventService_GetEvents_FullMethodName       = "/api.EventService/GetEvents"
+	EventService_UpdateEvent_FullMethodName     = "/api.EventService/UpdateEvent"
+	EventService_DeleteEvent_FullMethodName     = "/api.EventService/DeleteEvent"
+	EventService_ListOccurrences_FullMethodName = "/api.EventService/ListOccurrences"
+	EventService_ImportEvents_FullMethodName    = "/api.EventService/ImportEvents"
+	EventService_AssignRotation_FullMethodName  = "/api.EventService/AssignRotation"
+	EventService_GetWorkload_FullMethodName     = "/api.EventService/GetWorkload"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -896,6 +1694,19 @@ type EventServiceClient interface {
 	// DeleteEvent removes an event from the system.
 	// Permanently deletes the event and all its associations.
 	DeleteEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListOccurrences expands recurring events into the concrete occurrences
+	// that overlap a time window, with single-occurrence edits applied.
+	ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	// ImportEvents reads an iCalendar file into a household's events.
+	// Events imported before are matched by UID and updated in place; with
+	// dry_run set the changes are only reported.
+	ImportEvents(ctx context.Context, in *ImportEventsRequest, opts ...grpc.CallOption) (*ImportEventsResponse, error)
+	// AssignRotation shares the occurrences of a recurring event in a window
+	// among household members, saving each as a single-occurrence edit.
+	AssignRotation(ctx context.Context, in *AssignRotationRequest, opts ...grpc.CallOption) (*AssignRotationResponse, error)
+	// GetWorkload summarizes how many occurrences, and how much time, each
+	// member of a household is assigned in a period.
+	GetWorkload(ctx context.Context, in *GetWorkloadRequest, opts ...grpc.CallOption) (*WorkloadResponse, error)
 }
 
 type eventServiceClient struct {
@@ -956,6 +1767,46 @@ func (c *eventServiceClient) DeleteEvent(ctx context.Context, in *GetEventReques
 	return out, nil
 }
 
+func (c *eventServiceClient) ListOccurrences(ctx context.Context, in *ListOccurrencesRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ListOccurrences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ImportEvents(ctx context.Context, in *ImportEventsRequest, opts ...grpc.CallOption) (*ImportEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_ImportEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) AssignRotation(ctx context.Context, in *AssignRotationRequest, opts ...grpc.CallOption) (*AssignRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRotationResponse)
+	err := c.cc.Invoke(ctx, EventService_AssignRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetWorkload(ctx context.Context, in *GetWorkloadRequest, opts ...grpc.CallOption) (*WorkloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkloadResponse)
+	err := c.cc.Invoke(ctx, EventService_GetWorkload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventServiceServer is the server API for EventService service.
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
@@ -979,6 +1830,19 @@ type EventServiceServer interface {
 	// DeleteEvent removes an event from the system.
 	// Permanently deletes the event and all its associations.
 	DeleteEvent(context.Context, *GetEventRequest) (*emptypb.Empty, error)
+	// ListOccurrences expands recurring events into the concrete occurrences
+	// that overlap a time window, with single-occurrence edits applied.
+	ListOccurrences(context.Context, *ListOccurrencesRequest) (*EventsResponse, error)
+	// ImportEvents reads an iCalendar file into a household's events.
+	// Events imported before are matched by UID and updated in place; with
+	// dry_run set the changes are only reported.
+	ImportEvents(context.Context, *ImportEventsRequest) (*ImportEventsResponse, error)
+	// AssignRotation shares the occurrences of a recurring event in a window
+	// among household members, saving each as a single-occurrence edit.
+	AssignRotation(context.Context, *AssignRotationRequest) (*AssignRotationResponse, error)
+	// GetWorkload summarizes how many occurrences, and how much time, each
+	// member of a household is assigned in a period.
+	GetWorkload(context.Context, *GetWorkloadRequest) (*WorkloadResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -1004,6 +1868,18 @@ func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEvent
 func (UnimplementedEventServiceServer) DeleteEvent(context.Context, *GetEventRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEvent not implemented")
 }
+func (UnimplementedEventServiceServer) ListOccurrences(context.Context, *ListOccurrencesRequest) (*EventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOccurrences not implemented")
+}
+func (UnimplementedEventServiceServer) ImportEvents(context.Context, *ImportEventsRequest) (*ImportEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportEvents not implemented")
+}
+func (UnimplementedEventServiceServer) AssignRotation(context.Context, *AssignRotationRequest) (*AssignRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignRotation not implemented")
+}
+func (UnimplementedEventServiceServer) GetWorkload(context.Context, *GetWorkloadRequest) (*WorkloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkload not implemented")
+}
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
@@ -1115,6 +1991,78 @@ func _EventService_DeleteEvent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_ListOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListOccurrences(ctx, req.(*ListOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ImportEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ImportEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ImportEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ImportEvents(ctx, req.(*ImportEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_AssignRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).AssignRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_AssignRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).AssignRotation(ctx, req.(*AssignRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetWorkload(ctx, req.(*GetWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1142,6 +2090,22 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEvent",
 			Handler:    _EventService_DeleteEvent_Handler,
 		},
+		{
+			MethodName: "ListOccurrences",
+			Handler:    _EventService_ListOccurrences_Handler,
+		},
+		{
+			MethodName: "ImportEvents",
+			Handler:    _EventService_ImportEvents_Handler,
+		},
+		{
+			MethodName: "AssignRotation",
+			Handler:    _EventService_AssignRotation_Handler,
+		},
+		{
+			MethodName: "GetWorkload",
+			Handler:    _EventService_GetWorkload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "hmly.proto",
@@ -0,0 +1,32 @@
+package hmlyv2
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/hmlylab/common/proto"
+	"google.golang.org/grpc"
+)
+
+// RegisterGatewayHandlers registers the HTTP handlers of every service in
+// both API versions on mux, so existing clients keep using /v1 while new ones
+// move to /v2. The server behind conn must implement both.
+func RegisterGatewayHandlers(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		v1.RegisterHouseholdServiceHandler,
+		v1.RegisterMemberServiceHandler,
+		v1.RegisterMealServiceHandler,
+		v1.RegisterEventServiceHandler,
+		v1.RegisterShoppingListServiceHandler,
+		RegisterHouseholdServiceHandler,
+		RegisterMemberServiceHandler,
+		RegisterMealServiceHandler,
+		RegisterEventServiceHandler,
+		RegisterShoppingListServiceHandler,
+	} {
+		if err := register(ctx, mux, conn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
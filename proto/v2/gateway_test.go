@@ -0,0 +1,80 @@
+package hmlyv2
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/hmlylab/common/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var start = time.Date(2025, 5, 5, 18, 0, 0, 0, time.UTC)
+
+type eventsV1 struct {
+	v1.UnimplementedEventServiceServer
+}
+
+func (eventsV1) GetEvent(_ context.Context, req *v1.GetEventRequest) (*v1.EventResponse, error) {
+	return &v1.EventResponse{Id: req.GetId(), StartDate: start.Format(time.RFC3339)}, nil
+}
+
+type eventsV2 struct {
+	UnimplementedEventServiceServer
+}
+
+func (eventsV2) GetEvent(_ context.Context, req *GetEventRequest) (*EventResponse, error) {
+	return &EventResponse{Id: req.GetId(), StartDate: timestamppb.New(start)}, nil
+}
+
+func TestRegisterGatewayHandlers(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	v1.RegisterEventServiceServer(srv, eventsV1{})
+	RegisterEventServiceServer(srv, eventsV2{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	mux := runtime.NewServeMux()
+	if err := RegisterGatewayHandlers(context.Background(), mux, conn); err != nil {
+		t.Fatalf("Failed to register handlers: %v", err)
+	}
+	gw := httptest.NewServer(mux)
+	t.Cleanup(gw.Close)
+
+	for _, version := range []string{"v1", "v2"} {
+		resp, err := http.Get(gw.URL + "/" + version + "/events/e1")
+		if err != nil {
+			t.Fatalf("%s: %v", version, err)
+		}
+		var body struct {
+			ID        string `json:"id"`
+			StartDate string `json:"startDate"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("%s: status %d, %v", version, resp.StatusCode, err)
+		}
+		// Both encode the start as RFC 3339 in JSON; only v2 is typed in gRPC.
+		if body.ID != "e1" || body.StartDate != "2025-05-05T18:00:00Z" {
+			t.Errorf("%s: got %+v", version, body)
+		}
+	}
+}
@@ -0,0 +1,5347 @@
+// Proto3 syntax definition for version 2 of the HMLY (Home Life) API.
+// It mirrors version 1 with timestamps as google.protobuf.Timestamp instead
+// of ISO 8601 strings. Both versions are served side by side: gRPC services
+// live in different packages and HTTP routes under /v1 and /v2.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: v2/hmly.proto
+
+// Package namespace for all version 2 services and messages
+
+package hmlyv2
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MealSlot is the meal of the day a plan entry is for.
+type MealSlot int32
+
+const (
+	MealSlot_MEAL_SLOT_UNSPECIFIED MealSlot = 0 // Not set; rejected when saving
+	MealSlot_MEAL_SLOT_BREAKFAST   MealSlot = 1 // Breakfast
+	MealSlot_MEAL_SLOT_LUNCH       MealSlot = 2 // Lunch
+	MealSlot_MEAL_SLOT_DINNER      MealSlot = 3 // Dinner
+	MealSlot_MEAL_SLOT_SNACK       MealSlot = 4 // Snack
+)
+
+// Enum value maps for MealSlot.
+var (
+	MealSlot_name = map[int32]string{
+		0: "MEAL_SLOT_UNSPECIFIED",
+		1: "MEAL_SLOT_BREAKFAST",
+		2: "MEAL_SLOT_LUNCH",
+		3: "MEAL_SLOT_DINNER",
+		4: "MEAL_SLOT_SNACK",
+	}
+	MealSlot_value = map[string]int32{
+		"MEAL_SLOT_UNSPECIFIED": 0,
+		"MEAL_SLOT_BREAKFAST":   1,
+		"MEAL_SLOT_LUNCH":       2,
+		"MEAL_SLOT_DINNER":      3,
+		"MEAL_SLOT_SNACK":       4,
+	}
+)
+
+func (x MealSlot) Enum() *MealSlot {
+	p := new(MealSlot)
+	*p = x
+	return p
+}
+
+func (x MealSlot) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MealSlot) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_hmly_proto_enumTypes[0].Descriptor()
+}
+
+func (MealSlot) Type() protoreflect.EnumType {
+	return &file_v2_hmly_proto_enumTypes[0]
+}
+
+func (x MealSlot) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MealSlot.Descriptor instead.
+func (MealSlot) EnumDescriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{0}
+}
+
+// EditScope selects the occurrences of a recurring event that an update
+// changes.
+type EditScope int32
+
+const (
+	EditScope_EDIT_SCOPE_ALL                EditScope = 0 // The whole series
+	EditScope_EDIT_SCOPE_THIS               EditScope = 1 // Only the occurrence at recurrence_id
+	EditScope_EDIT_SCOPE_THIS_AND_FOLLOWING EditScope = 2 // The occurrence at recurrence_id and all later ones
+)
+
+// Enum value maps for EditScope.
+var (
+	EditScope_name = map[int32]string{
+		0: "EDIT_SCOPE_ALL",
+		1: "EDIT_SCOPE_THIS",
+		2: "EDIT_SCOPE_THIS_AND_FOLLOWING",
+	}
+	EditScope_value = map[string]int32{
+		"EDIT_SCOPE_ALL":                0,
+		"EDIT_SCOPE_THIS":               1,
+		"EDIT_SCOPE_THIS_AND_FOLLOWING": 2,
+	}
+)
+
+func (x EditScope) Enum() *EditScope {
+	p := new(EditScope)
+	*p = x
+	return p
+}
+
+func (x EditScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EditScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_hmly_proto_enumTypes[1].Descriptor()
+}
+
+func (EditScope) Type() protoreflect.EnumType {
+	return &file_v2_hmly_proto_enumTypes[1]
+}
+
+func (x EditScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EditScope.Descriptor instead.
+func (EditScope) EnumDescriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{1}
+}
+
+// RotationStrategy selects how AssignRotation picks members.
+type RotationStrategy int32
+
+const (
+	RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN RotationStrategy = 0 // Members take turns
+	RotationStrategy_ROTATION_STRATEGY_LEAST_LOAD  RotationStrategy = 1 // The member with the least work so far goes next
+)
+
+// Enum value maps for RotationStrategy.
+var (
+	RotationStrategy_name = map[int32]string{
+		0: "ROTATION_STRATEGY_ROUND_ROBIN",
+		1: "ROTATION_STRATEGY_LEAST_LOAD",
+	}
+	RotationStrategy_value = map[string]int32{
+		"ROTATION_STRATEGY_ROUND_ROBIN": 0,
+		"ROTATION_STRATEGY_LEAST_LOAD":  1,
+	}
+)
+
+func (x RotationStrategy) Enum() *RotationStrategy {
+	p := new(RotationStrategy)
+	*p = x
+	return p
+}
+
+func (x RotationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RotationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_hmly_proto_enumTypes[2].Descriptor()
+}
+
+func (RotationStrategy) Type() protoreflect.EnumType {
+	return &file_v2_hmly_proto_enumTypes[2]
+}
+
+func (x RotationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RotationStrategy.Descriptor instead.
+func (RotationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{2}
+}
+
+// CreateHouseholdRequest is used to create a new household.
+type CreateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                         // Display name for the household
+	TimeZone      string                 `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA time zone of the household (default UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHouseholdRequest) Reset() {
+	*x = CreateHouseholdRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHouseholdRequest) ProtoMessage() {}
+
+func (x *CreateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHouseholdRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// GetHouseholdRequest is used to retrieve a specific household.
+type GetHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the household
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdRequest) Reset() {
+	*x = GetHouseholdRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdRequest) ProtoMessage() {}
+
+func (x *GetHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetHouseholdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetHouseholdsRequest is used for paginated household listing.
+type GetHouseholdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"` // Number of households to skip
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // Maximum number of households to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHouseholdsRequest) Reset() {
+	*x = GetHouseholdsRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHouseholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHouseholdsRequest) ProtoMessage() {}
+
+func (x *GetHouseholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHouseholdsRequest.ProtoReflect.Descriptor instead.
+func (*GetHouseholdsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetHouseholdsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetHouseholdsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// UpdateHouseholdRequest is used to modify an existing household.
+type UpdateHouseholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // Unique identifier of the household to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                               // New display name for the household
+	TimeZone      *string                `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"` // New IANA time zone for the household
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHouseholdRequest) Reset() {
+	*x = UpdateHouseholdRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHouseholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHouseholdRequest) ProtoMessage() {}
+
+func (x *UpdateHouseholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHouseholdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHouseholdRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateHouseholdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHouseholdRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+// HouseholdResponse represents a complete household entity.
+type HouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique identifier
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Display name of the household
+	ErrorMessage  *Error                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // When the household was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // When the household was last updated
+	TimeZone      string                 `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`                   // IANA time zone of the household
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdResponse) Reset() {
+	*x = HouseholdResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdResponse) ProtoMessage() {}
+
+func (x *HouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HouseholdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HouseholdResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HouseholdResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *HouseholdResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HouseholdResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *HouseholdResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// Error represents standardized error information across all services.
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`      // Numeric error code (HTTP-style or custom)
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // Human-readable error description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_v2_hmly_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// HouseholdsResponse represents a paginated list of households.
+type HouseholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Households    []*HouseholdResponse   `protobuf:"bytes,1,rep,name=households,proto3" json:"households,omitempty"`                               // Array of household entities
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdsResponse) Reset() {
+	*x = HouseholdsResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdsResponse) ProtoMessage() {}
+
+func (x *HouseholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdsResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HouseholdsResponse) GetHouseholds() []*HouseholdResponse {
+	if x != nil {
+		return x.Households
+	}
+	return nil
+}
+
+func (x *HouseholdsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateMemberRequest links a user to a household.
+type CreateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household to join
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ID of the user becoming a member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMemberRequest) Reset() {
+	*x = CreateMemberRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMemberRequest) ProtoMessage() {}
+
+func (x *CreateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMemberRequest.ProtoReflect.Descriptor instead.
+func (*CreateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetMemberRequest retrieves a specific member by their unique ID.
+type GetMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemberRequest) Reset() {
+	*x = GetMemberRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemberRequest) ProtoMessage() {}
+
+func (x *GetMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemberRequest.ProtoReflect.Descriptor instead.
+func (*GetMemberRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMemberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetMembersRequest retrieves all members of a household.
+type GetMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household to get members for
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMembersRequest) Reset() {
+	*x = GetMembersRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMembersRequest) ProtoMessage() {}
+
+func (x *GetMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetMembersRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMembersRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// UpdateMemberRequest modifies an existing member's associations.
+type UpdateMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Unique identifier of the member to update
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // New household ID
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // New user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMemberRequest) Reset() {
+	*x = UpdateMemberRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMemberRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// MemberResponse represents a complete member entity.
+type MemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique member identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // ID of the associated user
+	ErrorMessage  *Error                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // When the membership was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // When the membership was last updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberResponse) Reset() {
+	*x = MemberResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberResponse) ProtoMessage() {}
+
+func (x *MemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberResponse.ProtoReflect.Descriptor instead.
+func (*MemberResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MemberResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MemberResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *MemberResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *MemberResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MemberResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// MembersResponse represents a list of household members.
+type MembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*MemberResponse      `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`                                     // Array of member entities
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MembersResponse) Reset() {
+	*x = MembersResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MembersResponse) ProtoMessage() {}
+
+func (x *MembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MembersResponse.ProtoReflect.Descriptor instead.
+func (*MembersResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MembersResponse) GetMembers() []*MemberResponse {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *MembersResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateMealRequest creates a new meal for a household.
+type CreateMealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Name of the meal
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household this meal belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMealRequest) Reset() {
+	*x = CreateMealRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMealRequest) ProtoMessage() {}
+
+func (x *CreateMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMealRequest.ProtoReflect.Descriptor instead.
+func (*CreateMealRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateMealRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMealRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// GetMealRequest retrieves a specific meal by its unique ID.
+type GetMealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the meal
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealRequest) Reset() {
+	*x = GetMealRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealRequest) ProtoMessage() {}
+
+func (x *GetMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealRequest.ProtoReflect.Descriptor instead.
+func (*GetMealRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMealRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetMealsRequest retrieves all meals for a household.
+type GetMealsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household to get meals for
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealsRequest) Reset() {
+	*x = GetMealsRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealsRequest) ProtoMessage() {}
+
+func (x *GetMealsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealsRequest.ProtoReflect.Descriptor instead.
+func (*GetMealsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetMealsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// UpdateMealRequest modifies an existing meal.
+type UpdateMealRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // Unique identifier of the meal to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // New name for the meal
+	HouseholdId   string                 `protobuf:"bytes,3,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // New household ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMealRequest) Reset() {
+	*x = UpdateMealRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMealRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMealRequest) ProtoMessage() {}
+
+func (x *UpdateMealRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMealRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMealRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateMealRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMealRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMealRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// MealResponse represents a complete meal entity.
+type MealResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique meal identifier
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the meal
+	HouseholdId   string                 `protobuf:"bytes,3,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	ErrorMessage  *Error                 `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // When the meal was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // When the meal was last updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealResponse) Reset() {
+	*x = MealResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealResponse) ProtoMessage() {}
+
+func (x *MealResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealResponse.ProtoReflect.Descriptor instead.
+func (*MealResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MealResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MealResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *MealResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *MealResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// MealsResponse represents a list of meals for a household.
+type MealsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meals         []*MealResponse        `protobuf:"bytes,1,rep,name=meals,proto3" json:"meals,omitempty"`                                         // Array of meal entities
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealsResponse) Reset() {
+	*x = MealsResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealsResponse) ProtoMessage() {}
+
+func (x *MealsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealsResponse.ProtoReflect.Descriptor instead.
+func (*MealsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MealsResponse) GetMeals() []*MealResponse {
+	if x != nil {
+		return x.Meals
+	}
+	return nil
+}
+
+func (x *MealsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// Ingredient is an amount of something a recipe needs, e.g. 200 g of flour.
+type Ingredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // What is needed, e.g. "flour"
+	Quantity      float64                `protobuf:"fixed64,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // How much, in unit; 0 for "to taste"
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`           // Unit of quantity, e.g. "g", "ml" or "cup"; empty for a count
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`           // Preparation notes, e.g. "finely chopped"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_v2_hmly_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Ingredient) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Ingredient) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CreateRecipeRequest adds a recipe to a household.
+type CreateRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household this recipe belongs to
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Name of the recipe
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                    // Short description
+	Servings      int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`                         // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"`                    // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`                                // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`                                  // Tags such as "vegetarian"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecipeRequest) Reset() {
+	*x = CreateRecipeRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecipeRequest) ProtoMessage() {}
+
+func (x *CreateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateRecipeRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRecipeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRecipeRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CreateRecipeRequest) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *CreateRecipeRequest) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CreateRecipeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// GetRecipeRequest retrieves a specific recipe by its unique ID.
+type GetRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the recipe
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeRequest) Reset() {
+	*x = GetRecipeRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeRequest) ProtoMessage() {}
+
+func (x *GetRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetRecipesRequest lists a household's recipes by name.
+type GetRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household to get recipes for
+	Tag           *string                `protobuf:"bytes,2,opt,name=tag,proto3,oneof" json:"tag,omitempty"`                              // Only recipes with this tag
+	Search        *string                `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`                        // Only recipes whose name contains this, ignoring case
+	Offset        *int32                 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                       // Number of recipes to skip
+	Limit         *int32                 `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Maximum number of recipes to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipesRequest) Reset() {
+	*x = GetRecipesRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipesRequest) ProtoMessage() {}
+
+func (x *GetRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipesRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRecipesRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetRecipesRequest) GetTag() string {
+	if x != nil && x.Tag != nil {
+		return *x.Tag
+	}
+	return ""
+}
+
+func (x *GetRecipesRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+func (x *GetRecipesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetRecipesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// UpdateRecipeRequest replaces a recipe, including its ingredients.
+type UpdateRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // Unique identifier of the recipe to update
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // Name of the recipe
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // Short description
+	Servings      int32                  `protobuf:"varint,4,opt,name=servings,proto3" json:"servings,omitempty"`      // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,5,rep,name=ingredients,proto3" json:"ingredients,omitempty"` // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`             // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`               // Tags such as "vegetarian"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateRecipeRequest) Reset() {
+	*x = UpdateRecipeRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRecipeRequest) ProtoMessage() {}
+
+func (x *UpdateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateRecipeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateRecipeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateRecipeRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *UpdateRecipeRequest) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *UpdateRecipeRequest) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *UpdateRecipeRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// RecipeResponse represents a complete recipe.
+type RecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique recipe identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the recipe
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                             // Short description
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                                  // Portions the ingredient quantities make
+	Ingredients   []*Ingredient          `protobuf:"bytes,6,rep,name=ingredients,proto3" json:"ingredients,omitempty"`                             // Ingredients in the order they are listed
+	Steps         []string               `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`                                         // Method, one step per entry
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`                                           // Tags such as "vegetarian"
+	ErrorMessage  *Error                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // When it was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // When it was last updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeResponse) Reset() {
+	*x = RecipeResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeResponse) ProtoMessage() {}
+
+func (x *RecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeResponse.ProtoReflect.Descriptor instead.
+func (*RecipeResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecipeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecipeResponse) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *RecipeResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetSteps() []string {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecipeResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RecipesResponse represents a list of recipes.
+type RecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipes       []*RecipeResponse      `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`                                     // Array of recipes
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipesResponse) Reset() {
+	*x = RecipesResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipesResponse) ProtoMessage() {}
+
+func (x *RecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipesResponse.ProtoReflect.Descriptor instead.
+func (*RecipesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecipesResponse) GetRecipes() []*RecipeResponse {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *RecipesResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateMealPlanEntryRequest plans a recipe for a meal.
+type CreateMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household whose plan this is
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                  // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,3,opt,name=slot,proto3,enum=api.v2.MealSlot" json:"slot,omitempty"`            // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,4,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`          // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                         // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                                  // Free-form note, e.g. "double for leftovers"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMealPlanEntryRequest) Reset() {
+	*x = CreateMealPlanEntryRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *CreateMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*CreateMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateMealPlanEntryRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *CreateMealPlanEntryRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CreateMealPlanEntryRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CreateMealPlanEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// GetMealPlanEntryRequest retrieves a specific plan entry by its unique ID.
+type GetMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the plan entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanEntryRequest) Reset() {
+	*x = GetMealPlanEntryRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *GetMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetMealPlanEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetMealPlanRequest lists a household's plan by date and then slot.
+type GetMealPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                                // First day, YYYY-MM-DD, inclusive
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                                    // Last day, YYYY-MM-DD, exclusive
+	Slot          *MealSlot              `protobuf:"varint,4,opt,name=slot,proto3,enum=api.v2.MealSlot,oneof" json:"slot,omitempty"`      // Only entries for this meal of the day
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMealPlanRequest) Reset() {
+	*x = GetMealPlanRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMealPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMealPlanRequest) ProtoMessage() {}
+
+func (x *GetMealPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMealPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetMealPlanRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetMealPlanRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *GetMealPlanRequest) GetSlot() MealSlot {
+	if x != nil && x.Slot != nil {
+		return *x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+// UpdateMealPlanEntryRequest replaces a plan entry.
+type UpdateMealPlanEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // Unique identifier of the plan entry to update
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                         // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,3,opt,name=slot,proto3,enum=api.v2.MealSlot" json:"slot,omitempty"`   // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,4,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`                         // Free-form note, e.g. "double for leftovers"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMealPlanEntryRequest) Reset() {
+	*x = UpdateMealPlanEntryRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMealPlanEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMealPlanEntryRequest) ProtoMessage() {}
+
+func (x *UpdateMealPlanEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMealPlanEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMealPlanEntryRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateMealPlanEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *UpdateMealPlanEntryRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *UpdateMealPlanEntryRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *UpdateMealPlanEntryRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// MealPlanEntryResponse represents a planned meal with its recipe.
+type MealPlanEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique plan entry identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                                           // Day of the meal, YYYY-MM-DD
+	Slot          MealSlot               `protobuf:"varint,4,opt,name=slot,proto3,enum=api.v2.MealSlot" json:"slot,omitempty"`                     // Meal of the day
+	RecipeId      string                 `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`                   // ID of the recipe to cook
+	Servings      int32                  `protobuf:"varint,6,opt,name=servings,proto3" json:"servings,omitempty"`                                  // Portions to make; 0 for the recipe's own
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`                                           // Free-form note, e.g. "double for leftovers"
+	Recipe        *RecipeResponse        `protobuf:"bytes,8,opt,name=recipe,proto3" json:"recipe,omitempty"`                                       // The recipe, with its ingredients
+	ErrorMessage  *Error                 `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // When it was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // When it was last updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanEntryResponse) Reset() {
+	*x = MealPlanEntryResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanEntryResponse) ProtoMessage() {}
+
+func (x *MealPlanEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanEntryResponse.ProtoReflect.Descriptor instead.
+func (*MealPlanEntryResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MealPlanEntryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetSlot() MealSlot {
+	if x != nil {
+		return x.Slot
+	}
+	return MealSlot_MEAL_SLOT_UNSPECIFIED
+}
+
+func (x *MealPlanEntryResponse) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *MealPlanEntryResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *MealPlanEntryResponse) GetRecipe() *RecipeResponse {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *MealPlanEntryResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *MealPlanEntryResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanEntryResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// MealPlanResponse represents a household's meal plan.
+type MealPlanResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Entries       []*MealPlanEntryResponse `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`                                     // Entries by date and then slot
+	ErrorMessage  *Error                   `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealPlanResponse) Reset() {
+	*x = MealPlanResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanResponse) ProtoMessage() {}
+
+func (x *MealPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanResponse.ProtoReflect.Descriptor instead.
+func (*MealPlanResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MealPlanResponse) GetEntries() []*MealPlanEntryResponse {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *MealPlanResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// ShoppingItem is an amount of something to buy.
+type ShoppingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                // Unique item identifier
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // What to buy
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                  // How much, in unit; 0 for "to taste"
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                            // Unit of quantity: "g", "kg", "ml", "l", empty for a count, or as the recipe wrote it
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                            // Free-form note
+	Recipes       []string               `protobuf:"bytes,6,rep,name=recipes,proto3" json:"recipes,omitempty"`                      // Names of the planned recipes that need it
+	Manual        bool                   `protobuf:"varint,7,opt,name=manual,proto3" json:"manual,omitempty"`                       // Whether a member added it by hand
+	Checked       bool                   `protobuf:"varint,8,opt,name=checked,proto3" json:"checked,omitempty"`                     // Whether it has been bought
+	CheckedBy     string                 `protobuf:"bytes,9,opt,name=checked_by,json=checkedBy,proto3" json:"checked_by,omitempty"` // User ID of the member who checked it off
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingItem) Reset() {
+	*x = ShoppingItem{}
+	mi := &file_v2_hmly_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingItem) ProtoMessage() {}
+
+func (x *ShoppingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingItem.ProtoReflect.Descriptor instead.
+func (*ShoppingItem) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ShoppingItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShoppingItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShoppingItem) GetRecipes() []string {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *ShoppingItem) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *ShoppingItem) GetCheckedBy() string {
+	if x != nil {
+		return x.CheckedBy
+	}
+	return ""
+}
+
+// CreateShoppingListRequest builds a list from a household's meal plan.
+type CreateShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // Name of the list, e.g. "Week 19"
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                                // First planned day, YYYY-MM-DD, inclusive
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                                    // Last planned day, YYYY-MM-DD, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShoppingListRequest) Reset() {
+	*x = CreateShoppingListRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShoppingListRequest) ProtoMessage() {}
+
+func (x *CreateShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*CreateShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateShoppingListRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CreateShoppingListRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// GetShoppingListRequest retrieves a specific list by its unique ID.
+type GetShoppingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the list
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListRequest) Reset() {
+	*x = GetShoppingListRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListRequest) ProtoMessage() {}
+
+func (x *GetShoppingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetShoppingListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetShoppingListsRequest lists a household's shopping lists.
+type GetShoppingListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household
+	Offset        *int32                 `protobuf:"varint,2,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                       // Number of lists to skip
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                         // Maximum number of lists to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShoppingListsRequest) Reset() {
+	*x = GetShoppingListsRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShoppingListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShoppingListsRequest) ProtoMessage() {}
+
+func (x *GetShoppingListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShoppingListsRequest.ProtoReflect.Descriptor instead.
+func (*GetShoppingListsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetShoppingListsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetShoppingListsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetShoppingListsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// AddShoppingItemRequest adds an item to a list by hand.
+type AddShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                   // What to buy
+	Quantity      float64                `protobuf:"fixed64,3,opt,name=quantity,proto3" json:"quantity,omitempty"`         // How much, in unit
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                   // Unit of quantity, empty for a count
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                   // Free-form note
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddShoppingItemRequest) Reset() {
+	*x = AddShoppingItemRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddShoppingItemRequest) ProtoMessage() {}
+
+func (x *AddShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*AddShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetQuantity() float64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AddShoppingItemRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AddShoppingItemRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// CheckShoppingItemRequest checks an item off, or back on.
+type CheckShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                       // ID of the item
+	Checked       bool                   `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`            // Whether the item has been bought
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID of the member checking it off
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShoppingItemRequest) Reset() {
+	*x = CheckShoppingItemRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShoppingItemRequest) ProtoMessage() {}
+
+func (x *CheckShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*CheckShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CheckShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CheckShoppingItemRequest) GetChecked() bool {
+	if x != nil {
+		return x.Checked
+	}
+	return false
+}
+
+func (x *CheckShoppingItemRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeleteShoppingItemRequest removes an item from a list.
+type DeleteShoppingItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ListId        string                 `protobuf:"bytes,1,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"` // ID of the list
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                       // ID of the item
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShoppingItemRequest) Reset() {
+	*x = DeleteShoppingItemRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShoppingItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShoppingItemRequest) ProtoMessage() {}
+
+func (x *DeleteShoppingItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShoppingItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShoppingItemRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteShoppingItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *DeleteShoppingItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ShoppingListResponse represents a complete shopping list.
+type ShoppingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique list identifier
+	HouseholdId   string                 `protobuf:"bytes,2,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`          // ID of the associated household
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                           // Name of the list
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`                                         // First planned day, YYYY-MM-DD
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`                                             // Day after the last planned day, YYYY-MM-DD
+	Items         []*ShoppingItem        `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                         // Items from the plan by name, then manual items
+	ErrorMessage  *Error                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // When it was created
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // When it was last updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListResponse) Reset() {
+	*x = ShoppingListResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListResponse) ProtoMessage() {}
+
+func (x *ShoppingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ShoppingListResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *ShoppingListResponse) GetItems() []*ShoppingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShoppingListResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ShoppingListsResponse represents a list of shopping lists.
+type ShoppingListsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lists         []*ShoppingListResponse `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`                                         // Shopping lists, latest first
+	ErrorMessage  *Error                  `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShoppingListsResponse) Reset() {
+	*x = ShoppingListsResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShoppingListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShoppingListsResponse) ProtoMessage() {}
+
+func (x *ShoppingListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShoppingListsResponse.ProtoReflect.Descriptor instead.
+func (*ShoppingListsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ShoppingListsResponse) GetLists() []*ShoppingListResponse {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+func (x *ShoppingListsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// CreateEventRequest creates a new scheduled event.
+type CreateEventRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Name          string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                   // Descriptive name for the event
+	EntityId      string                   `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`           // ID of the entity this event relates to
+	EntityType    string                   `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`     // Type of entity (e.g., "household", "meal")
+	StartDate     *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`        // Start of the event
+	EndDate       *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`              // End of the event; if unset, start_date plus duration
+	Duration      *durationpb.Duration     `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`                           // Length of the event, used when end_date is unset
+	AssignedTo    string                   `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`     // User ID of the person assigned to this event
+	Rrule         string                   `protobuf:"bytes,8,opt,name=rrule,proto3" json:"rrule,omitempty"`                                 // RFC 5545 recurrence rule (empty for one-off events)
+	Exdates       []*timestamppb.Timestamp `protobuf:"bytes,9,rep,name=exdates,proto3" json:"exdates,omitempty"`                             // Starts of cancelled occurrences
+	AllDay        bool                     `protobuf:"varint,10,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`               // Whether the event spans whole days
+	HouseholdId   string                   `protobuf:"bytes,11,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"` // ID of the household the event belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CreateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetExdates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *CreateEventRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// GetEventRequest retrieves a specific event by its unique ID.
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Unique identifier of the event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetEventsRequest retrieves a filtered list of events.
+type GetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    *string                `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"`    // Filter events by entity type
+	EntityId      *string                `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`          // Filter events by entity ID
+	HouseholdId   *string                `protobuf:"bytes,3,opt,name=household_id,json=householdId,proto3,oneof" json:"household_id,omitempty"` // Filter events by household ID
+	AssignedTo    *string                `protobuf:"bytes,4,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`    // Filter events by assignee user ID
+	Start         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`                                      // Start of the window events must overlap, inclusive
+	End           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`                                          // End of the window events must overlap, exclusive
+	TimeZone      *string                `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`          // IANA time zone whose days all-day events cover (default UTC)
+	Offset        *int32                 `protobuf:"varint,8,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                             // Number of events to skip
+	Limit         *int32                 `protobuf:"varint,9,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Maximum number of events to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetEventsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetHouseholdId() string {
+	if x != nil && x.HouseholdId != nil {
+		return *x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetEventsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// UpdateEventRequest modifies an existing event's properties.
+type UpdateEventRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                          // Unique identifier of the event to update
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                      // New descriptive name for the event
+	EntityId      string                   `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`              // New entity ID
+	EntityType    string                   `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`        // New entity type
+	StartDate     *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`           // New start
+	EndDate       *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                 // New end; if unset, start_date plus duration
+	Duration      *durationpb.Duration     `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`                              // New length, used when end_date is unset
+	AssignedTo    string                   `protobuf:"bytes,8,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`        // New user ID for assignment
+	Rrule         string                   `protobuf:"bytes,9,opt,name=rrule,proto3" json:"rrule,omitempty"`                                    // New recurrence rule (empty for one-off events)
+	Exdates       []*timestamppb.Timestamp `protobuf:"bytes,10,rep,name=exdates,proto3" json:"exdates,omitempty"`                               // New starts of cancelled occurrences
+	RecurrenceId  *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"` // Original start of the occurrence to edit
+	Scope         EditScope                `protobuf:"varint,12,opt,name=scope,proto3,enum=api.v2.EditScope" json:"scope,omitempty"`            // Which occurrences the update applies to
+	AllDay        bool                     `protobuf:"varint,13,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                  // Whether the event spans whole days
+	HouseholdId   string                   `protobuf:"bytes,14,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // New household ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetExdates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetRecurrenceId() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetScope() EditScope {
+	if x != nil {
+		return x.Scope
+	}
+	return EditScope_EDIT_SCOPE_ALL
+}
+
+func (x *UpdateEventRequest) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *UpdateEventRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// EventResponse represents a complete event entity.
+type EventResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // Unique event identifier
+	Name          string                   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // Descriptive name of the event
+	EntityId      string                   `protobuf:"bytes,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`                   // ID of the associated entity
+	EntityType    string                   `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`             // Type of the associated entity
+	StartDate     *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                // Start of the event
+	EndDate       *timestamppb.Timestamp   `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                      // End of the event
+	Duration      *durationpb.Duration     `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`                                   // Length of the event
+	AssignedTo    string                   `protobuf:"bytes,8,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`             // User ID of the assigned person
+	ErrorMessage  *Error                   `protobuf:"bytes,9,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	CreatedAt     *timestamppb.Timestamp   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`               // When the event was created
+	UpdatedAt     *timestamppb.Timestamp   `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`               // When the event was last updated
+	Rrule         string                   `protobuf:"bytes,12,opt,name=rrule,proto3" json:"rrule,omitempty"`                                        // RFC 5545 recurrence rule (empty for one-off events)
+	Exdates       []*timestamppb.Timestamp `protobuf:"bytes,13,rep,name=exdates,proto3" json:"exdates,omitempty"`                                    // Starts of cancelled occurrences
+	SeriesId      string                   `protobuf:"bytes,14,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`                  // ID of the series this event or occurrence belongs to
+	RecurrenceId  *timestamppb.Timestamp   `protobuf:"bytes,15,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"`      // Original start of the occurrence
+	AllDay        bool                     `protobuf:"varint,16,opt,name=all_day,json=allDay,proto3" json:"all_day,omitempty"`                       // Whether the event spans whole days
+	HouseholdId   string                   `protobuf:"bytes,17,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`         // ID of the household the event belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventResponse) Reset() {
+	*x = EventResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResponse) ProtoMessage() {}
+
+func (x *EventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventResponse.ProtoReflect.Descriptor instead.
+func (*EventResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EventResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventResponse) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *EventResponse) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *EventResponse) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *EventResponse) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *EventResponse) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *EventResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+func (x *EventResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EventResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *EventResponse) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *EventResponse) GetExdates() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *EventResponse) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *EventResponse) GetRecurrenceId() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return nil
+}
+
+func (x *EventResponse) GetAllDay() bool {
+	if x != nil {
+		return x.AllDay
+	}
+	return false
+}
+
+func (x *EventResponse) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+// ListOccurrencesRequest selects the events to expand and the window to
+// expand them in.
+type ListOccurrencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    *string                `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"`    // Filter events by entity type
+	EntityId      *string                `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`          // Filter events by entity ID
+	Start         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`                                      // Start of the window, inclusive
+	End           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`                                          // End of the window, exclusive
+	HouseholdId   *string                `protobuf:"bytes,5,opt,name=household_id,json=householdId,proto3,oneof" json:"household_id,omitempty"` // Filter events by household ID
+	AssignedTo    *string                `protobuf:"bytes,6,opt,name=assigned_to,json=assignedTo,proto3,oneof" json:"assigned_to,omitempty"`    // Filter events by assignee user ID
+	TimeZone      *string                `protobuf:"bytes,7,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`          // IANA time zone whose days all-day events cover (default UTC)
+	Offset        *int32                 `protobuf:"varint,8,opt,name=offset,proto3,oneof" json:"offset,omitempty"`                             // Number of occurrences to skip
+	Limit         *int32                 `protobuf:"varint,9,opt,name=limit,proto3,oneof" json:"limit,omitempty"`                               // Maximum number of occurrences to return
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOccurrencesRequest) Reset() {
+	*x = ListOccurrencesRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOccurrencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOccurrencesRequest) ProtoMessage() {}
+
+func (x *ListOccurrencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOccurrencesRequest.ProtoReflect.Descriptor instead.
+func (*ListOccurrencesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListOccurrencesRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ListOccurrencesRequest) GetHouseholdId() string {
+	if x != nil && x.HouseholdId != nil {
+		return *x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetAssignedTo() string {
+	if x != nil && x.AssignedTo != nil {
+		return *x.AssignedTo
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *ListOccurrencesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListOccurrencesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// EventsResponse represents a list of events.
+type EventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*EventResponse       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`                                       // Array of event entities
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventsResponse) Reset() {
+	*x = EventsResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsResponse) ProtoMessage() {}
+
+func (x *EventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsResponse.ProtoReflect.Descriptor instead.
+func (*EventsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *EventsResponse) GetEvents() []*EventResponse {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *EventsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// ImportEventsRequest carries an iCalendar file to import.
+type ImportEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // Household the events are imported into
+	Ics           string                 `protobuf:"bytes,2,opt,name=ics,proto3" json:"ics,omitempty"`                                       // iCalendar (RFC 5545) data
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                  // Report the changes without saving them
+	TimeZone      *string                `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`       // IANA time zone for floating times (default UTC)
+	EntityType    *string                `protobuf:"bytes,5,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"` // Entity the events belong to (default the household)
+	EntityId      *string                `protobuf:"bytes,6,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`       // Entity ID the events belong to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEventsRequest) Reset() {
+	*x = ImportEventsRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEventsRequest) ProtoMessage() {}
+
+func (x *ImportEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEventsRequest.ProtoReflect.Descriptor instead.
+func (*ImportEventsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportEventsRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetIcs() string {
+	if x != nil {
+		return x.Ics
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEventsRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ImportEventsRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+// ImportedEvent is what an import did, or would do, with one VEVENT.
+type ImportedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // "create", "update" or "unchanged"
+	Uid           string                 `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`       // UID of the VEVENT
+	Event         *EventResponse         `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`   // The event as saved, or as it would be saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedEvent) Reset() {
+	*x = ImportedEvent{}
+	mi := &file_v2_hmly_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedEvent) ProtoMessage() {}
+
+func (x *ImportedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedEvent.ProtoReflect.Descriptor instead.
+func (*ImportedEvent) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ImportedEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportedEvent) GetEvent() *EventResponse {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// ImportProblem is a VEVENT that was skipped.
+type ImportProblem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`         // UID of the VEVENT, if it had one
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`      // Line the VEVENT starts on
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // Why it was skipped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProblem) Reset() {
+	*x = ImportProblem{}
+	mi := &file_v2_hmly_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProblem) ProtoMessage() {}
+
+func (x *ImportProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProblem.ProtoReflect.Descriptor instead.
+func (*ImportProblem) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ImportProblem) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ImportProblem) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ImportEventsResponse reports an import.
+type ImportEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int32                  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`                                    // Number of events created
+	Updated       int32                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`                                    // Number of events updated
+	Unchanged     int32                  `protobuf:"varint,3,opt,name=unchanged,proto3" json:"unchanged,omitempty"`                                // Number of events already up to date
+	Events        []*ImportedEvent       `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`                                       // One entry per imported VEVENT
+	Problems      []*ImportProblem       `protobuf:"bytes,5,rep,name=problems,proto3" json:"problems,omitempty"`                                   // VEVENTs that were skipped
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Whether nothing was saved
+	ErrorMessage  *Error                 `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEventsResponse) Reset() {
+	*x = ImportEventsResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEventsResponse) ProtoMessage() {}
+
+func (x *ImportEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEventsResponse.ProtoReflect.Descriptor instead.
+func (*ImportEventsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ImportEventsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetUnchanged() int32 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ImportEventsResponse) GetEvents() []*ImportedEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ImportEventsResponse) GetProblems() []*ImportProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *ImportEventsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportEventsResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// Unavailability is a period a member cannot take occurrences in.
+type Unavailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // start of the period, inclusive
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // end of the period, exclusive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unavailability) Reset() {
+	*x = Unavailability{}
+	mi := &file_v2_hmly_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unavailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unavailability) ProtoMessage() {}
+
+func (x *Unavailability) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unavailability.ProtoReflect.Descriptor instead.
+func (*Unavailability) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Unavailability) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Unavailability) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// RotationMember is a member taking part in a rotation.
+type RotationMember struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                       // User ID of the member
+	Unavailable      []*Unavailability      `protobuf:"bytes,2,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                                           // Periods the member is away
+	ExcludedWeekdays []int32                `protobuf:"varint,3,rep,packed,name=excluded_weekdays,json=excludedWeekdays,proto3" json:"excluded_weekdays,omitempty"` // Days the member never takes, 0 = Sunday to 6 = Saturday
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RotationMember) Reset() {
+	*x = RotationMember{}
+	mi := &file_v2_hmly_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotationMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationMember) ProtoMessage() {}
+
+func (x *RotationMember) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationMember.ProtoReflect.Descriptor instead.
+func (*RotationMember) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RotationMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RotationMember) GetUnavailable() []*Unavailability {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+func (x *RotationMember) GetExcludedWeekdays() []int32 {
+	if x != nil {
+		return x.ExcludedWeekdays
+	}
+	return nil
+}
+
+// AssignRotationRequest shares a recurring event's occurrences in a window.
+type AssignRotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                  // ID of the recurring event
+	Strategy      RotationStrategy       `protobuf:"varint,2,opt,name=strategy,proto3,enum=api.v2.RotationStrategy" json:"strategy,omitempty"` // How members are picked
+	Members       []*RotationMember      `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`                                 // Members in turn order
+	Start         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`                                     // start of the window, inclusive
+	End           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`                                         // end of the window, exclusive
+	TimeZone      *string                `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`         // IANA time zone for weekdays and all-day events (default UTC)
+	After         *string                `protobuf:"bytes,7,opt,name=after,proto3,oneof" json:"after,omitempty"`                               // User ID of the member who took the previous occurrence
+	DryRun        bool                   `protobuf:"varint,8,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                    // Report the assignments without saving them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRotationRequest) Reset() {
+	*x = AssignRotationRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRotationRequest) ProtoMessage() {}
+
+func (x *AssignRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRotationRequest.ProtoReflect.Descriptor instead.
+func (*AssignRotationRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AssignRotationRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetStrategy() RotationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return RotationStrategy_ROTATION_STRATEGY_ROUND_ROBIN
+}
+
+func (x *AssignRotationRequest) GetMembers() []*RotationMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *AssignRotationRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AssignRotationRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *AssignRotationRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+func (x *AssignRotationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// RotationAssignment gives one occurrence to a member.
+type RotationAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecurrenceId  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=recurrence_id,json=recurrenceId,proto3" json:"recurrence_id,omitempty"` // original start of the occurrence
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`          // start of the occurrence
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                // end of the occurrence
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // Member assigned, empty if nobody was available
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotationAssignment) Reset() {
+	*x = RotationAssignment{}
+	mi := &file_v2_hmly_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotationAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationAssignment) ProtoMessage() {}
+
+func (x *RotationAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationAssignment.ProtoReflect.Descriptor instead.
+func (*RotationAssignment) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RotationAssignment) GetRecurrenceId() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecurrenceId
+	}
+	return nil
+}
+
+func (x *RotationAssignment) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *RotationAssignment) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *RotationAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// AssignRotationResponse lists the assignments made, or that would be made.
+type AssignRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*RotationAssignment  `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`                             // One per occurrence in the window
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                        // Whether nothing was saved
+	ErrorMessage  *Error                 `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRotationResponse) Reset() {
+	*x = AssignRotationResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRotationResponse) ProtoMessage() {}
+
+func (x *AssignRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRotationResponse.ProtoReflect.Descriptor instead.
+func (*AssignRotationResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AssignRotationResponse) GetAssignments() []*RotationAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+func (x *AssignRotationResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AssignRotationResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+// GetWorkloadRequest selects the period and events to summarize.
+type GetWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdId   string                 `protobuf:"bytes,1,opt,name=household_id,json=householdId,proto3" json:"household_id,omitempty"`    // ID of the household
+	Start         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`                                   // start of the period, inclusive
+	End           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`                                       // end of the period, exclusive
+	TimeZone      *string                `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`       // IANA time zone whose days all-day events cover (default UTC)
+	EntityType    *string                `protobuf:"bytes,5,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"` // Only count events of this entity type, e.g. "chore"
+	UserIds       []string               `protobuf:"bytes,6,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`                // Members to include even if they have nothing assigned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadRequest) Reset() {
+	*x = GetWorkloadRequest{}
+	mi := &file_v2_hmly_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadRequest) ProtoMessage() {}
+
+func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetWorkloadRequest) GetHouseholdId() string {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *GetWorkloadRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *GetWorkloadRequest) GetTimeZone() string {
+	if x != nil && x.TimeZone != nil {
+		return *x.TimeZone
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// MemberWorkload is how much one member is assigned.
+type MemberWorkload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // User ID of the member, empty for unassigned occurrences
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                // Number of occurrences
+	Duration      *durationpb.Duration   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`           // Total time assigned
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberWorkload) Reset() {
+	*x = MemberWorkload{}
+	mi := &file_v2_hmly_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberWorkload) ProtoMessage() {}
+
+func (x *MemberWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberWorkload.ProtoReflect.Descriptor instead.
+func (*MemberWorkload) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MemberWorkload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberWorkload) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MemberWorkload) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// WorkloadResponse lists the workload of each member.
+type WorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     []*MemberWorkload      `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`                                 // Ordered by user ID
+	ErrorMessage  *Error                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Error details if operation failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadResponse) Reset() {
+	*x = WorkloadResponse{}
+	mi := &file_v2_hmly_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadResponse) ProtoMessage() {}
+
+func (x *WorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_hmly_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadResponse.ProtoReflect.Descriptor instead.
+func (*WorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_v2_hmly_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WorkloadResponse) GetWorkloads() []*MemberWorkload {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+func (x *WorkloadResponse) GetErrorMessage() *Error {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+var File_v2_hmly_proto protoreflect.FileDescriptor
+
+const file_v2_hmly_proto_rawDesc = "" +
+	"\n" +
+	"\rv2/hmly.proto\x12\x06api.v2\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"I\n" +
+	"\x16CreateHouseholdRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"%\n" +
+	"\x13GetHouseholdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x14GetHouseholdsRequest\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"l\n" +
+	"\x16UpdateHouseholdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\ttime_zone\x18\x03 \x01(\tH\x00R\btimeZone\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zone\"\x95\x02\n" +
+	"\x11HouseholdResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x127\n" +
+	"\rerror_message\x18\x03 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\ttime_zone\x18\x06 \x01(\tR\btimeZoneB\x10\n" +
+	"\x0e_error_message\"5\n" +
+	"\x05Error\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9a\x01\n" +
+	"\x12HouseholdsResponse\x129\n" +
+	"\n" +
+	"households\x18\x01 \x03(\v2\x19.api.v2.HouseholdResponseR\n" +
+	"households\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"Q\n" +
+	"\x13CreateMemberRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\"\n" +
+	"\x10GetMemberRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x11GetMembersRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"a\n" +
+	"\x13UpdateMemberRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x9d\x02\n" +
+	"\x0eMemberResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x127\n" +
+	"\rerror_message\x18\x04 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x8e\x01\n" +
+	"\x0fMembersResponse\x120\n" +
+	"\amembers\x18\x01 \x03(\v2\x16.api.v2.MemberResponseR\amembers\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"J\n" +
+	"\x11CreateMealRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\" \n" +
+	"\x0eGetMealRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x0fGetMealsRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\"Z\n" +
+	"\x11UpdateMealRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fhousehold_id\x18\x03 \x01(\tR\vhouseholdId\"\x96\x02\n" +
+	"\fMealResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fhousehold_id\x18\x03 \x01(\tR\vhouseholdId\x127\n" +
+	"\rerror_message\x18\x04 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x86\x01\n" +
+	"\rMealsResponse\x12*\n" +
+	"\x05meals\x18\x01 \x03(\v2\x14.api.v2.MealResponseR\x05meals\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"d\n" +
+	"\n" +
+	"Ingredient\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"\xea\x01\n" +
+	"\x13CreateRecipeRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x124\n" +
+	"\vingredients\x18\x05 \x03(\v2\x12.api.v2.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\x06 \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"\"\n" +
+	"\x10GetRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xca\x01\n" +
+	"\x11GetRecipesRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x15\n" +
+	"\x03tag\x18\x02 \x01(\tH\x00R\x03tag\x88\x01\x01\x12\x1b\n" +
+	"\x06search\x18\x03 \x01(\tH\x01R\x06search\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\x04 \x01(\x05H\x02R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x05 \x01(\x05H\x03R\x05limit\x88\x01\x01B\x06\n" +
+	"\x04_tagB\t\n" +
+	"\a_searchB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\xd7\x01\n" +
+	"\x13UpdateRecipeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x04 \x01(\x05R\bservings\x124\n" +
+	"\vingredients\x18\x05 \x03(\v2\x12.api.v2.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\x06 \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\"\xb6\x03\n" +
+	"\x0eRecipeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x124\n" +
+	"\vingredients\x18\x06 \x03(\v2\x12.api.v2.IngredientR\vingredients\x12\x14\n" +
+	"\x05steps\x18\a \x03(\tR\x05steps\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x127\n" +
+	"\rerror_message\x18\t \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x8e\x01\n" +
+	"\x0fRecipesResponse\x120\n" +
+	"\arecipes\x18\x01 \x03(\v2\x16.api.v2.RecipeResponseR\arecipes\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xc6\x01\n" +
+	"\x1aCreateMealPlanEntryRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12$\n" +
+	"\x04slot\x18\x03 \x01(\x0e2\x10.api.v2.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x04 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\")\n" +
+	"\x17GetMealPlanEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x01\n" +
+	"\x12GetMealPlanRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12)\n" +
+	"\x04slot\x18\x04 \x01(\x0e2\x10.api.v2.MealSlotH\x00R\x04slot\x88\x01\x01B\a\n" +
+	"\x05_slot\"\xb3\x01\n" +
+	"\x1aUpdateMealPlanEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12$\n" +
+	"\x04slot\x18\x03 \x01(\x0e2\x10.api.v2.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x04 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"\xc2\x03\n" +
+	"\x15MealPlanEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12$\n" +
+	"\x04slot\x18\x04 \x01(\x0e2\x10.api.v2.MealSlotR\x04slot\x12\x1b\n" +
+	"\trecipe_id\x18\x05 \x01(\tR\brecipeId\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\x05R\bservings\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\x12.\n" +
+	"\x06recipe\x18\b \x01(\v2\x16.api.v2.RecipeResponseR\x06recipe\x127\n" +
+	"\rerror_message\x18\t \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x96\x01\n" +
+	"\x10MealPlanResponse\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.api.v2.MealPlanEntryResponseR\aentries\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xe1\x01\n" +
+	"\fShoppingItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x18\n" +
+	"\arecipes\x18\x06 \x03(\tR\arecipes\x12\x16\n" +
+	"\x06manual\x18\a \x01(\bR\x06manual\x12\x18\n" +
+	"\achecked\x18\b \x01(\bR\achecked\x12\x1d\n" +
+	"\n" +
+	"checked_by\x18\t \x01(\tR\tcheckedBy\"z\n" +
+	"\x19CreateShoppingListRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"(\n" +
+	"\x16GetShoppingListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x89\x01\n" +
+	"\x17GetShoppingListsRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x1b\n" +
+	"\x06offset\x18\x02 \x01(\x05H\x00R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x01R\x05limit\x88\x01\x01B\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\x89\x01\n" +
+	"\x16AddShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x01R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"v\n" +
+	"\x18CheckShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x18\n" +
+	"\achecked\x18\x03 \x01(\bR\achecked\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"D\n" +
+	"\x19DeleteShoppingItemRequest\x12\x17\n" +
+	"\alist_id\x18\x01 \x01(\tR\x06listId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xf2\x02\n" +
+	"\x14ShoppingListResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fhousehold_id\x18\x02 \x01(\tR\vhouseholdId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\x12*\n" +
+	"\x05items\x18\x06 \x03(\v2\x14.api.v2.ShoppingItemR\x05items\x127\n" +
+	"\rerror_message\x18\a \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\x96\x01\n" +
+	"\x15ShoppingListsResponse\x122\n" +
+	"\x05lists\x18\x01 \x03(\v2\x1c.api.v2.ShoppingListResponseR\x05lists\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xb8\x03\n" +
+	"\x12CreateEventRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1f\n" +
+	"\vassigned_to\x18\a \x01(\tR\n" +
+	"assignedTo\x12\x14\n" +
+	"\x05rrule\x18\b \x01(\tR\x05rrule\x124\n" +
+	"\aexdates\x18\t \x03(\v2\x1a.google.protobuf.TimestampR\aexdates\x12\x17\n" +
+	"\aall_day\x18\n" +
+	" \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\v \x01(\tR\vhouseholdId\"!\n" +
+	"\x0fGetEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc4\x03\n" +
+	"\x10GetEventsRequest\x12$\n" +
+	"\ventity_type\x18\x01 \x01(\tH\x00R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x02 \x01(\tH\x01R\bentityId\x88\x01\x01\x12&\n" +
+	"\fhousehold_id\x18\x03 \x01(\tH\x02R\vhouseholdId\x88\x01\x01\x12$\n" +
+	"\vassigned_to\x18\x04 \x01(\tH\x03R\n" +
+	"assignedTo\x88\x01\x01\x120\n" +
+	"\x05start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12 \n" +
+	"\ttime_zone\x18\a \x01(\tH\x04R\btimeZone\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\b \x01(\x05H\x05R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\t \x01(\x05H\x06R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_idB\x0f\n" +
+	"\r_household_idB\x0e\n" +
+	"\f_assigned_toB\f\n" +
+	"\n" +
+	"_time_zoneB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\xb2\x04\n" +
+	"\x12UpdateEventRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1f\n" +
+	"\vassigned_to\x18\b \x01(\tR\n" +
+	"assignedTo\x12\x14\n" +
+	"\x05rrule\x18\t \x01(\tR\x05rrule\x124\n" +
+	"\aexdates\x18\n" +
+	" \x03(\v2\x1a.google.protobuf.TimestampR\aexdates\x12?\n" +
+	"\rrecurrence_id\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\frecurrenceId\x12'\n" +
+	"\x05scope\x18\f \x01(\x0e2\x11.api.v2.EditScopeR\x05scope\x12\x17\n" +
+	"\aall_day\x18\r \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\x0e \x01(\tR\vhouseholdId\"\xe2\x05\n" +
+	"\rEventResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\tR\bentityId\x12\x1f\n" +
+	"\ventity_type\x18\x04 \x01(\tR\n" +
+	"entityType\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x125\n" +
+	"\bduration\x18\a \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x1f\n" +
+	"\vassigned_to\x18\b \x01(\tR\n" +
+	"assignedTo\x127\n" +
+	"\rerror_message\x18\t \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x14\n" +
+	"\x05rrule\x18\f \x01(\tR\x05rrule\x124\n" +
+	"\aexdates\x18\r \x03(\v2\x1a.google.protobuf.TimestampR\aexdates\x12\x1b\n" +
+	"\tseries_id\x18\x0e \x01(\tR\bseriesId\x12?\n" +
+	"\rrecurrence_id\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\frecurrenceId\x12\x17\n" +
+	"\aall_day\x18\x10 \x01(\bR\x06allDay\x12!\n" +
+	"\fhousehold_id\x18\x11 \x01(\tR\vhouseholdIdB\x10\n" +
+	"\x0e_error_message\"\xca\x03\n" +
+	"\x16ListOccurrencesRequest\x12$\n" +
+	"\ventity_type\x18\x01 \x01(\tH\x00R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x02 \x01(\tH\x01R\bentityId\x88\x01\x01\x120\n" +
+	"\x05start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12&\n" +
+	"\fhousehold_id\x18\x05 \x01(\tH\x02R\vhouseholdId\x88\x01\x01\x12$\n" +
+	"\vassigned_to\x18\x06 \x01(\tH\x03R\n" +
+	"assignedTo\x88\x01\x01\x12 \n" +
+	"\ttime_zone\x18\a \x01(\tH\x04R\btimeZone\x88\x01\x01\x12\x1b\n" +
+	"\x06offset\x18\b \x01(\x05H\x05R\x06offset\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\t \x01(\x05H\x06R\x05limit\x88\x01\x01B\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_idB\x0f\n" +
+	"\r_household_idB\x0e\n" +
+	"\f_assigned_toB\f\n" +
+	"\n" +
+	"_time_zoneB\t\n" +
+	"\a_offsetB\b\n" +
+	"\x06_limit\"\x8a\x01\n" +
+	"\x0eEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.api.v2.EventResponseR\x06events\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\xf9\x01\n" +
+	"\x13ImportEventsRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x12\x10\n" +
+	"\x03ics\x18\x02 \x01(\tR\x03ics\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12 \n" +
+	"\ttime_zone\x18\x04 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12$\n" +
+	"\ventity_type\x18\x05 \x01(\tH\x01R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x06 \x01(\tH\x02R\bentityId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_time_zoneB\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_id\"f\n" +
+	"\rImportedEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x10\n" +
+	"\x03uid\x18\x02 \x01(\tR\x03uid\x12+\n" +
+	"\x05event\x18\x03 \x01(\v2\x15.api.v2.EventResponseR\x05event\"O\n" +
+	"\rImportProblem\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\tR\x03uid\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xae\x02\n" +
+	"\x14ImportEventsResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x05R\aupdated\x12\x1c\n" +
+	"\tunchanged\x18\x03 \x01(\x05R\tunchanged\x12-\n" +
+	"\x06events\x18\x04 \x03(\v2\x15.api.v2.ImportedEventR\x06events\x121\n" +
+	"\bproblems\x18\x05 \x03(\v2\x15.api.v2.ImportProblemR\bproblems\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x127\n" +
+	"\rerror_message\x18\a \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"p\n" +
+	"\x0eUnavailability\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\"\x90\x01\n" +
+	"\x0eRotationMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x128\n" +
+	"\vunavailable\x18\x02 \x03(\v2\x16.api.v2.UnavailabilityR\vunavailable\x12+\n" +
+	"\x11excluded_weekdays\x18\x03 \x03(\x05R\x10excludedWeekdays\"\xe8\x02\n" +
+	"\x15AssignRotationRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x124\n" +
+	"\bstrategy\x18\x02 \x01(\x0e2\x18.api.v2.RotationStrategyR\bstrategy\x120\n" +
+	"\amembers\x18\x03 \x03(\v2\x16.api.v2.RotationMemberR\amembers\x120\n" +
+	"\x05start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12 \n" +
+	"\ttime_zone\x18\x06 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\a \x01(\tH\x01R\x05after\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\b \x01(\bR\x06dryRunB\f\n" +
+	"\n" +
+	"_time_zoneB\b\n" +
+	"\x06_after\"\xe0\x01\n" +
+	"\x12RotationAssignment\x12?\n" +
+	"\rrecurrence_id\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\frecurrenceId\x129\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\xba\x01\n" +
+	"\x16AssignRotationResponse\x12<\n" +
+	"\vassignments\x18\x01 \x03(\v2\x1a.api.v2.RotationAssignmentR\vassignments\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x127\n" +
+	"\rerror_message\x18\x03 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message\"\x98\x02\n" +
+	"\x12GetWorkloadRequest\x12!\n" +
+	"\fhousehold_id\x18\x01 \x01(\tR\vhouseholdId\x120\n" +
+	"\x05start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12 \n" +
+	"\ttime_zone\x18\x04 \x01(\tH\x00R\btimeZone\x88\x01\x01\x12$\n" +
+	"\ventity_type\x18\x05 \x01(\tH\x01R\n" +
+	"entityType\x88\x01\x01\x12\x19\n" +
+	"\buser_ids\x18\x06 \x03(\tR\auserIdsB\f\n" +
+	"\n" +
+	"_time_zoneB\x0e\n" +
+	"\f_entity_type\"v\n" +
+	"\x0eMemberWorkload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\"\x93\x01\n" +
+	"\x10WorkloadResponse\x124\n" +
+	"\tworkloads\x18\x01 \x03(\v2\x16.api.v2.MemberWorkloadR\tworkloads\x127\n" +
+	"\rerror_message\x18\x02 \x01(\v2\r.api.v2.ErrorH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message*~\n" +
+	"\bMealSlot\x12\x19\n" +
+	"\x15MEAL_SLOT_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13MEAL_SLOT_BREAKFAST\x10\x01\x12\x13\n" +
+	"\x0fMEAL_SLOT_LUNCH\x10\x02\x12\x14\n" +
+	"\x10MEAL_SLOT_DINNER\x10\x03\x12\x13\n" +
+	"\x0fMEAL_SLOT_SNACK\x10\x04*W\n" +
+	"\tEditScope\x12\x12\n" +
+	"\x0eEDIT_SCOPE_ALL\x10\x00\x12\x13\n" +
+	"\x0fEDIT_SCOPE_THIS\x10\x01\x12!\n" +
+	"\x1dEDIT_SCOPE_THIS_AND_FOLLOWING\x10\x02*W\n" +
+	"\x10RotationStrategy\x12!\n" +
+	"\x1dROTATION_STRATEGY_ROUND_ROBIN\x10\x00\x12 \n" +
+	"\x1cROTATION_STRATEGY_LEAST_LOAD\x10\x012\x96\x04\n" +
+	"\x10HouseholdService\x12g\n" +
+	"\x0fCreateHousehold\x12\x1e.api.v2.CreateHouseholdRequest\x1a\x19.api.v2.HouseholdResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v2/households\x12c\n" +
+	"\fGetHousehold\x12\x1b.api.v2.GetHouseholdRequest\x1a\x19.api.v2.HouseholdResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v2/households/{id}\x12a\n" +
+	"\rGetHouseholds\x12\x1c.api.v2.GetHouseholdsRequest\x1a\x1a.api.v2.HouseholdsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v2/households\x12l\n" +
+	"\x0fUpdateHousehold\x12\x1e.api.v2.UpdateHouseholdRequest\x1a\x19.api.v2.HouseholdResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v2/households/{id}\x12c\n" +
+	"\x0fDeleteHousehold\x12\x1b.api.v2.GetHouseholdRequest\x1a\x16.google.protobuf.Empty\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/v2/households/{id}2\xda\x03\n" +
+	"\rMemberService\x12[\n" +
+	"\fCreateMember\x12\x1b.api.v2.CreateMemberRequest\x1a\x16.api.v2.MemberResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v2/members\x12W\n" +
+	"\tGetMember\x12\x18.api.v2.GetMemberRequest\x1a\x16.api.v2.MemberResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/members/{id}\x12U\n" +
+	"\n" +
+	"GetMembers\x12\x19.api.v2.GetMembersRequest\x1a\x17.api.v2.MembersResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v2/members\x12`\n" +
+	"\fUpdateMember\x12\x1b.api.v2.UpdateMemberRequest\x1a\x16.api.v2.MemberResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v2/members/{id}\x12Z\n" +
+	"\fDeleteMember\x12\x18.api.v2.GetMemberRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v2/members/{id}2\xa2\v\n" +
+	"\vMealService\x12S\n" +
+	"\n" +
+	"CreateMeal\x12\x19.api.v2.CreateMealRequest\x1a\x14.api.v2.MealResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v2/meals\x12O\n" +
+	"\aGetMeal\x12\x16.api.v2.GetMealRequest\x1a\x14.api.v2.MealResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v2/meals/{id}\x12M\n" +
+	"\bGetMeals\x12\x17.api.v2.GetMealsRequest\x1a\x15.api.v2.MealsResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v2/meals\x12X\n" +
+	"\n" +
+	"UpdateMeal\x12\x19.api.v2.UpdateMealRequest\x1a\x14.api.v2.MealResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\x1a\x0e/v2/meals/{id}\x12T\n" +
+	"\n" +
+	"DeleteMeal\x12\x16.api.v2.GetMealRequest\x1a\x16.google.protobuf.Empty\"\x16\x82\xd3\xe4\x93\x02\x10*\x0e/v2/meals/{id}\x12[\n" +
+	"\fCreateRecipe\x12\x1b.api.v2.CreateRecipeRequest\x1a\x16.api.v2.RecipeResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v2/recipes\x12W\n" +
+	"\tGetRecipe\x12\x18.api.v2.GetRecipeRequest\x1a\x16.api.v2.RecipeResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v2/recipes/{id}\x12U\n" +
+	"\n" +
+	"GetRecipes\x12\x19.api.v2.GetRecipesRequest\x1a\x17.api.v2.RecipesResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v2/recipes\x12`\n" +
+	"\fUpdateRecipe\x12\x1b.api.v2.UpdateRecipeRequest\x1a\x16.api.v2.RecipeResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v2/recipes/{id}\x12Z\n" +
+	"\fDeleteRecipe\x12\x18.api.v2.GetRecipeRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v2/recipes/{id}\x12r\n" +
+	"\x13CreateMealPlanEntry\x12\".api.v2.CreateMealPlanEntryRequest\x1a\x1d.api.v2.MealPlanEntryResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v2/meal-plan\x12n\n" +
+	"\x10GetMealPlanEntry\x12\x1f.api.v2.GetMealPlanEntryRequest\x1a\x1d.api.v2.MealPlanEntryResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/meal-plan/{id}\x12Z\n" +
+	"\vGetMealPlan\x12\x1a.api.v2.GetMealPlanRequest\x1a\x18.api.v2.MealPlanResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v2/meal-plan\x12w\n" +
+	"\x13UpdateMealPlanEntry\x12\".api.v2.UpdateMealPlanEntryRequest\x1a\x1d.api.v2.MealPlanEntryResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v2/meal-plan/{id}\x12j\n" +
+	"\x13DeleteMealPlanEntry\x12\x1f.api.v2.GetMealPlanEntryRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v2/meal-plan/{id}2\xea\a\n" +
+	"\x13ShoppingListService\x12t\n" +
+	"\x12CreateShoppingList\x12!.api.v2.CreateShoppingListRequest\x1a\x1c.api.v2.ShoppingListResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v2/shopping-lists\x12p\n" +
+	"\x0fGetShoppingList\x12\x1e.api.v2.GetShoppingListRequest\x1a\x1c.api.v2.ShoppingListResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v2/shopping-lists/{id}\x12n\n" +
+	"\x10GetShoppingLists\x12\x1f.api.v2.GetShoppingListsRequest\x1a\x1d.api.v2.ShoppingListsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v2/shopping-lists\x12\x7f\n" +
+	"\x13RefreshShoppingList\x12\x1e.api.v2.GetShoppingListRequest\x1a\x1c.api.v2.ShoppingListResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v2/shopping-lists/{id}:refresh\x12m\n" +
+	"\x12DeleteShoppingList\x12\x1e.api.v2.GetShoppingListRequest\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/v2/shopping-lists/{id}\x12~\n" +
+	"\x0fAddShoppingItem\x12\x1e.api.v2.AddShoppingItemRequest\x1a\x1c.api.v2.ShoppingListResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v2/shopping-lists/{list_id}/items\x12\x87\x01\n" +
+	"\x11CheckShoppingItem\x12 .api.v2.CheckShoppingItemRequest\x1a\x1c.api.v2.ShoppingListResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\x1a'/v2/shopping-lists/{list_id}/items/{id}\x12\x80\x01\n" +
+	"\x12DeleteShoppingItem\x12!.api.v2.DeleteShoppingItemRequest\x1a\x16.google.protobuf.Empty\"/\x82\xd3\xe4\x93\x02)*'/v2/shopping-lists/{list_id}/items/{id}2\x9f\a\n" +
+	"\fEventService\x12W\n" +
+	"\vCreateEvent\x12\x1a.api.v2.CreateEventRequest\x1a\x15.api.v2.EventResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v2/events\x12S\n" +
+	"\bGetEvent\x12\x17.api.v2.GetEventRequest\x1a\x15.api.v2.EventResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v2/events/{id}\x12Q\n" +
+	"\tGetEvents\x12\x18.api.v2.GetEventsRequest\x1a\x16.api.v2.EventsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v2/events\x12\\\n" +
+	"\vUpdateEvent\x12\x1a.api.v2.UpdateEventRequest\x1a\x15.api.v2.EventResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\x1a\x0f/v2/events/{id}\x12W\n" +
+	"\vDeleteEvent\x12\x17.api.v2.GetEventRequest\x1a\x16.google.protobuf.Empty\"\x17\x82\xd3\xe4\x93\x02\x11*\x0f/v2/events/{id}\x12b\n" +
+	"\x0fListOccurrences\x12\x1e.api.v2.ListOccurrencesRequest\x1a\x16.api.v2.EventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v2/occurrences\x12\x81\x01\n" +
+	"\fImportEvents\x12\x1b.api.v2.ImportEventsRequest\x1a\x1c.api.v2.ImportEventsResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v2/households/{household_id}/events:import\x12z\n" +
+	"\x0eAssignRotation\x12\x1d.api.v2.AssignRotationRequest\x1a\x1e.api.v2.AssignRotationResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v2/events/{event_id}/rotation\x12s\n" +
+	"\vGetWorkload\x12\x1a.api.v2.GetWorkloadRequest\x1a\x18.api.v2.WorkloadResponse\".\x82\xd3\xe4\x93\x02(\x12&/v2/households/{household_id}/workloadB+Z)github.com/hmlylab/common/proto/v2;hmlyv2b\x06proto3"
+
+var (
+	file_v2_hmly_proto_rawDescOnce sync.Once
+	file_v2_hmly_proto_rawDescData []byte
+)
+
+func file_v2_hmly_proto_rawDescGZIP() []byte {
+	file_v2_hmly_proto_rawDescOnce.Do(func() {
+		file_v2_hmly_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_hmly_proto_rawDesc), len(file_v2_hmly_proto_rawDesc)))
+	})
+	return file_v2_hmly_proto_rawDescData
+}
+
+var file_v2_hmly_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_v2_hmly_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_v2_hmly_proto_goTypes = []any{
+	(MealSlot)(0),                      // 0: api.v2.MealSlot
+	(EditScope)(0),                     // 1: api.v2.EditScope
+	(RotationStrategy)(0),              // 2: api.v2.RotationStrategy
+	(*CreateHouseholdRequest)(nil),     // 3: api.v2.CreateHouseholdRequest
+	(*GetHouseholdRequest)(nil),        // 4: api.v2.GetHouseholdRequest
+	(*GetHouseholdsRequest)(nil),       // 5: api.v2.GetHouseholdsRequest
+	(*UpdateHouseholdRequest)(nil),     // 6: api.v2.UpdateHouseholdRequest
+	(*HouseholdResponse)(nil),          // 7: api.v2.HouseholdResponse
+	(*Error)(nil),                      // 8: api.v2.Error
+	(*HouseholdsResponse)(nil),         // 9: api.v2.HouseholdsResponse
+	(*CreateMemberRequest)(nil),        // 10: api.v2.CreateMemberRequest
+	(*GetMemberRequest)(nil),           // 11: api.v2.GetMemberRequest
+	(*GetMembersRequest)(nil),          // 12: api.v2.GetMembersRequest
+	(*UpdateMemberRequest)(nil),        // 13: api.v2.UpdateMemberRequest
+	(*MemberResponse)(nil),             // 14: api.v2.MemberResponse
+	(*MembersResponse)(nil),            // 15: api.v2.MembersResponse
+	(*CreateMealRequest)(nil),          // 16: api.v2.CreateMealRequest
+	(*GetMealRequest)(nil),             // 17: api.v2.GetMealRequest
+	(*GetMealsRequest)(nil),            // 18: api.v2.GetMealsRequest
+	(*UpdateMealRequest)(nil),          // 19: api.v2.UpdateMealRequest
+	(*MealResponse)(nil),               // 20: api.v2.MealResponse
+	(*MealsResponse)(nil),              // 21: api.v2.MealsResponse
+	(*Ingredient)(nil),                 // 22: api.v2.Ingredient
+	(*CreateRecipeRequest)(nil),        // 23: api.v2.CreateRecipeRequest
+	(*GetRecipeRequest)(nil),           // 24: api.v2.GetRecipeRequest
+	(*GetRecipesRequest)(nil),          // 25: api.v2.GetRecipesRequest
+	(*UpdateRecipeRequest)(nil),        // 26: api.v2.UpdateRecipeRequest
+	(*RecipeResponse)(nil),             // 27: api.v2.RecipeResponse
+	(*RecipesResponse)(nil),            // 28: api.v2.RecipesResponse
+	(*CreateMealPlanEntryRequest)(nil), // 29: api.v2.CreateMealPlanEntryRequest
+	(*GetMealPlanEntryRequest)(nil),    // 30: api.v2.GetMealPlanEntryRequest
+	(*GetMealPlanRequest)(nil),         // 31: api.v2.GetMealPlanRequest
+	(*UpdateMealPlanEntryRequest)(nil), // 32: api.v2.UpdateMealPlanEntryRequest
+	(*MealPlanEntryResponse)(nil),      // 33: api.v2.MealPlanEntryResponse
+	(*MealPlanResponse)(nil),           // 34: api.v2.MealPlanResponse
+	(*ShoppingItem)(nil),               // 35: api.v2.ShoppingItem
+	(*CreateShoppingListRequest)(nil),  // 36: api.v2.CreateShoppingListRequest
+	(*GetShoppingListRequest)(nil),     // 37: api.v2.GetShoppingListRequest
+	(*GetShoppingListsRequest)(nil),    // 38: api.v2.GetShoppingListsRequest
+	(*AddShoppingItemRequest)(nil),     // 39: api.v2.AddShoppingItemRequest
+	(*CheckShoppingItemRequest)(nil),   // 40: api.v2.CheckShoppingItemRequest
+	(*DeleteShoppingItemRequest)(nil),  // 41: api.v2.DeleteShoppingItemRequest
+	(*ShoppingListResponse)(nil),       // 42: api.v2.ShoppingListResponse
+	(*ShoppingListsResponse)(nil),      // 43: api.v2.ShoppingListsResponse
+	(*CreateEventRequest)(nil),         // 44: api.v2.CreateEventRequest
+	(*GetEventRequest)(nil),            // 45: api.v2.GetEventRequest
+	(*GetEventsRequest)(nil),           // 46: api.v2.GetEventsRequest
+	(*UpdateEventRequest)(nil),         // 47: api.v2.UpdateEventRequest
+	(*EventResponse)(nil),              // 48: api.v2.EventResponse
+	(*ListOccurrencesRequest)(nil),     // 49: api.v2.ListOccurrencesRequest
+	(*EventsResponse)(nil),             // 50: api.v2.EventsResponse
+	(*ImportEventsRequest)(nil),        // 51: api.v2.ImportEventsRequest
+	(*ImportedEvent)(nil),              // 52: api.v2.ImportedEvent
+	(*ImportProblem)(nil),              // 53: api.v2.ImportProblem
+	(*ImportEventsResponse)(nil),       // 54: api.v2.ImportEventsResponse
+	(*Unavailability)(nil),             // 55: api.v2.Unavailability
+	(*RotationMember)(nil),             // 56: api.v2.RotationMember
+	(*AssignRotationRequest)(nil),      // 57: api.v2.AssignRotationRequest
+	(*RotationAssignment)(nil),         // 58: api.v2.RotationAssignment
+	(*AssignRotationResponse)(nil),     // 59: api.v2.AssignRotationResponse
+	(*GetWorkloadRequest)(nil),         // 60: api.v2.GetWorkloadRequest
+	(*MemberWorkload)(nil),             // 61: api.v2.MemberWorkload
+	(*WorkloadResponse)(nil),           // 62: api.v2.WorkloadResponse
+	(*timestamppb.Timestamp)(nil),      // 63: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),        // 64: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 65: google.protobuf.Empty
+}
+var file_v2_hmly_proto_depIdxs = []int32{
+	8,   // 0: api.v2.HouseholdResponse.error_message:type_name -> api.v2.Error
+	63,  // 1: api.v2.HouseholdResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 2: api.v2.HouseholdResponse.updated_at:type_name -> google.protobuf.Timestamp
+	7,   // 3: api.v2.HouseholdsResponse.households:type_name -> api.v2.HouseholdResponse
+	8,   // 4: api.v2.HouseholdsResponse.error_message:type_name -> api.v2.Error
+	8,   // 5: api.v2.MemberResponse.error_message:type_name -> api.v2.Error
+	63,  // 6: api.v2.MemberResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 7: api.v2.MemberResponse.updated_at:type_name -> google.protobuf.Timestamp
+	14,  // 8: api.v2.MembersResponse.members:type_name -> api.v2.MemberResponse
+	8,   // 9: api.v2.MembersResponse.error_message:type_name -> api.v2.Error
+	8,   // 10: api.v2.MealResponse.error_message:type_name -> api.v2.Error
+	63,  // 11: api.v2.MealResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 12: api.v2.MealResponse.updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 13: api.v2.MealsResponse.meals:type_name -> api.v2.MealResponse
+	8,   // 14: api.v2.MealsResponse.error_message:type_name -> api.v2.Error
+	22,  // 15: api.v2.CreateRecipeRequest.ingredients:type_name -> api.v2.Ingredient
+	22,  // 16: api.v2.UpdateRecipeRequest.ingredients:type_name -> api.v2.Ingredient
+	22,  // 17: api.v2.RecipeResponse.ingredients:type_name -> api.v2.Ingredient
+	8,   // 18: api.v2.RecipeResponse.error_message:type_name -> api.v2.Error
+	63,  // 19: api.v2.RecipeResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 20: api.v2.RecipeResponse.updated_at:type_name -> google.protobuf.Timestamp
+	27,  // 21: api.v2.RecipesResponse.recipes:type_name -> api.v2.RecipeResponse
+	8,   // 22: api.v2.RecipesResponse.error_message:type_name -> api.v2.Error
+	0,   // 23: api.v2.CreateMealPlanEntryRequest.slot:type_name -> api.v2.MealSlot
+	0,   // 24: api.v2.GetMealPlanRequest.slot:type_name -> api.v2.MealSlot
+	0,   // 25: api.v2.UpdateMealPlanEntryRequest.slot:type_name -> api.v2.MealSlot
+	0,   // 26: api.v2.MealPlanEntryResponse.slot:type_name -> api.v2.MealSlot
+	27,  // 27: api.v2.MealPlanEntryResponse.recipe:type_name -> api.v2.RecipeResponse
+	8,   // 28: api.v2.MealPlanEntryResponse.error_message:type_name -> api.v2.Error
+	63,  // 29: api.v2.MealPlanEntryResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 30: api.v2.MealPlanEntryResponse.updated_at:type_name -> google.protobuf.Timestamp
+	33,  // 31: api.v2.MealPlanResponse.entries:type_name -> api.v2.MealPlanEntryResponse
+	8,   // 32: api.v2.MealPlanResponse.error_message:type_name -> api.v2.Error
+	35,  // 33: api.v2.ShoppingListResponse.items:type_name -> api.v2.ShoppingItem
+	8,   // 34: api.v2.ShoppingListResponse.error_message:type_name -> api.v2.Error
+	63,  // 35: api.v2.ShoppingListResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 36: api.v2.ShoppingListResponse.updated_at:type_name -> google.protobuf.Timestamp
+	42,  // 37: api.v2.ShoppingListsResponse.lists:type_name -> api.v2.ShoppingListResponse
+	8,   // 38: api.v2.ShoppingListsResponse.error_message:type_name -> api.v2.Error
+	63,  // 39: api.v2.CreateEventRequest.start_date:type_name -> google.protobuf.Timestamp
+	63,  // 40: api.v2.CreateEventRequest.end_date:type_name -> google.protobuf.Timestamp
+	64,  // 41: api.v2.CreateEventRequest.duration:type_name -> google.protobuf.Duration
+	63,  // 42: api.v2.CreateEventRequest.exdates:type_name -> google.protobuf.Timestamp
+	63,  // 43: api.v2.GetEventsRequest.start:type_name -> google.protobuf.Timestamp
+	63,  // 44: api.v2.GetEventsRequest.end:type_name -> google.protobuf.Timestamp
+	63,  // 45: api.v2.UpdateEventRequest.start_date:type_name -> google.protobuf.Timestamp
+	63,  // 46: api.v2.UpdateEventRequest.end_date:type_name -> google.protobuf.Timestamp
+	64,  // 47: api.v2.UpdateEventRequest.duration:type_name -> google.protobuf.Duration
+	63,  // 48: api.v2.UpdateEventRequest.exdates:type_name -> google.protobuf.Timestamp
+	63,  // 49: api.v2.UpdateEventRequest.recurrence_id:type_name -> google.protobuf.Timestamp
+	1,   // 50: api.v2.UpdateEventRequest.scope:type_name -> api.v2.EditScope
+	63,  // 51: api.v2.EventResponse.start_date:type_name -> google.protobuf.Timestamp
+	63,  // 52: api.v2.EventResponse.end_date:type_name -> google.protobuf.Timestamp
+	64,  // 53: api.v2.EventResponse.duration:type_name -> google.protobuf.Duration
+	8,   // 54: api.v2.EventResponse.error_message:type_name -> api.v2.Error
+	63,  // 55: api.v2.EventResponse.created_at:type_name -> google.protobuf.Timestamp
+	63,  // 56: api.v2.EventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	63,  // 57: api.v2.EventResponse.exdates:type_name -> google.protobuf.Timestamp
+	63,  // 58: api.v2.EventResponse.recurrence_id:type_name -> google.protobuf.Timestamp
+	63,  // 59: api.v2.ListOccurrencesRequest.start:type_name -> google.protobuf.Timestamp
+	63,  // 60: api.v2.ListOccurrencesRequest.end:type_name -> google.protobuf.Timestamp
+	48,  // 61: api.v2.EventsResponse.events:type_name -> api.v2.EventResponse
+	8,   // 62: api.v2.EventsResponse.error_message:type_name -> api.v2.Error
+	48,  // 63: api.v2.ImportedEvent.event:type_name -> api.v2.EventResponse
+	52,  // 64: api.v2.ImportEventsResponse.events:type_name -> api.v2.ImportedEvent
+	53,  // 65: api.v2.ImportEventsResponse.problems:type_name -> api.v2.ImportProblem
+	8,   // 66: api.v2.ImportEventsResponse.error_message:type_name -> api.v2.Error
+	63,  // 67: api.v2.Unavailability.start:type_name -> google.protobuf.Timestamp
+	63,  // 68: api.v2.Unavailability.end:type_name -> google.protobuf.Timestamp
+	55,  // 69: api.v2.RotationMember.unavailable:type_name -> api.v2.Unavailability
+	2,   // 70: api.v2.AssignRotationRequest.strategy:type_name -> api.v2.RotationStrategy
+	56,  // 71: api.v2.AssignRotationRequest.members:type_name -> api.v2.RotationMember
+	63,  // 72: api.v2.AssignRotationRequest.start:type_name -> google.protobuf.Timestamp
+	63,  // 73: api.v2.AssignRotationRequest.end:type_name -> google.protobuf.Timestamp
+	63,  // 74: api.v2.RotationAssignment.recurrence_id:type_name -> google.protobuf.Timestamp
+	63,  // 75: api.v2.RotationAssignment.start_date:type_name -> google.protobuf.Timestamp
+	63,  // 76: api.v2.RotationAssignment.end_date:type_name -> google.protobuf.Timestamp
+	58,  // 77: api.v2.AssignRotationResponse.assignments:type_name -> api.v2.RotationAssignment
+	8,   // 78: api.v2.AssignRotationResponse.error_message:type_name -> api.v2.Error
+	63,  // 79: api.v2.GetWorkloadRequest.start:type_name -> google.protobuf.Timestamp
+	63,  // 80: api.v2.GetWorkloadRequest.end:type_name -> google.protobuf.Timestamp
+	64,  // 81: api.v2.MemberWorkload.duration:type_name -> google.protobuf.Duration
+	61,  // 82: api.v2.WorkloadResponse.workloads:type_name -> api.v2.MemberWorkload
+	8,   // 83: api.v2.WorkloadResponse.error_message:type_name -> api.v2.Error
+	3,   // 84: api.v2.HouseholdService.CreateHousehold:input_type -> api.v2.CreateHouseholdRequest
+	4,   // 85: api.v2.HouseholdService.GetHousehold:input_type -> api.v2.GetHouseholdRequest
+	5,   // 86: api.v2.HouseholdService.GetHouseholds:input_type -> api.v2.GetHouseholdsRequest
+	6,   // 87: api.v2.HouseholdService.UpdateHousehold:input_type -> api.v2.UpdateHouseholdRequest
+	4,   // 88: api.v2.HouseholdService.DeleteHousehold:input_type -> api.v2.GetHouseholdRequest
+	10,  // 89: api.v2.MemberService.CreateMember:input_type -> api.v2.CreateMemberRequest
+	11,  // 90: api.v2.MemberService.GetMember:input_type -> api.v2.GetMemberRequest
+	12,  // 91: api.v2.MemberService.GetMembers:input_type -> api.v2.GetMembersRequest
+	13,  // 92: api.v2.MemberService.UpdateMember:input_type -> api.v2.UpdateMemberRequest
+	11,  // 93: api.v2.MemberService.DeleteMember:input_type -> api.v2.GetMemberRequest
+	16,  // 94: api.v2.MealService.CreateMeal:input_type -> api.v2.CreateMealRequest
+	17,  // 95: api.v2.MealService.GetMeal:input_type -> api.v2.GetMealRequest
+	18,  // 96: api.v2.MealService.GetMeals:input_type -> api.v2.GetMealsRequest
+	19,  // 97: api.v2.MealService.UpdateMeal:input_type -> api.v2.UpdateMealRequest
+	17,  // 98: api.v2.MealService.DeleteMeal:input_type -> api.v2.GetMealRequest
+	23,  // 99: api.v2.MealService.CreateRecipe:input_type -> api.v2.CreateRecipeRequest
+	24,  // 100: api.v2.MealService.GetRecipe:input_type -> api.v2.GetRecipeRequest
+	25,  // 101: api.v2.MealService.GetRecipes:input_type -> api.v2.GetRecipesRequest
+	26,  // 102: api.v2.MealService.UpdateRecipe:input_type -> api.v2.UpdateRecipeRequest
+	24,  // 103: api.v2.MealService.DeleteRecipe:input_type -> api.v2.GetRecipeRequest
+	29,  // 104: api.v2.MealService.CreateMealPlanEntry:input_type -> api.v2.CreateMealPlanEntryRequest
+	30,  // 105: api.v2.MealService.GetMealPlanEntry:input_type -> api.v2.GetMealPlanEntryRequest
+	31,  // 106: api.v2.MealService.GetMealPlan:input_type -> api.v2.GetMealPlanRequest
+	32,  // 107: api.v2.MealService.UpdateMealPlanEntry:input_type -> api.v2.UpdateMealPlanEntryRequest
+	30,  // 108: api.v2.MealService.DeleteMealPlanEntry:input_type -> api.v2.GetMealPlanEntryRequest
+	36,  // 109: api.v2.ShoppingListService.CreateShoppingList:input_type -> api.v2.CreateShoppingListRequest
+	37,  // 110: api.v2.ShoppingListService.GetShoppingList:input_type -> api.v2.GetShoppingListRequest
+	38,  // 111: api.v2.ShoppingListService.GetShoppingLists:input_type -> api.v2.GetShoppingListsRequest
+	37,  // 112: api.v2.ShoppingListService.RefreshShoppingList:input_type -> api.v2.GetShoppingListRequest
+	37,  // 113: api.v2.ShoppingListService.DeleteShoppingList:input_type -> api.v2.GetShoppingListRequest
+	39,  // 114: api.v2.ShoppingListService.AddShoppingItem:input_type -> api.v2.AddShoppingItemRequest
+	40,  // 115: api.v2.ShoppingListService.CheckShoppingItem:input_type -> api.v2.CheckShoppingItemRequest
+	41,  // 116: api.v2.ShoppingListService.DeleteShoppingItem:input_type -> api.v2.DeleteShoppingItemRequest
+	44,  // 117: api.v2.EventService.CreateEvent:input_type -> api.v2.CreateEventRequest
+	45,  // 118: api.v2.EventService.GetEvent:input_type -> api.v2.GetEventRequest
+	46,  // 119: api.v2.EventService.GetEvents:input_type -> api.v2.GetEventsRequest
+	47,  // 120: api.v2.EventService.UpdateEvent:input_type -> api.v2.UpdateEventRequest
+	45,  // 121: api.v2.EventService.DeleteEvent:input_type -> api.v2.GetEventRequest
+	49,  // 122: api.v2.EventService.ListOccurrences:input_type -> api.v2.ListOccurrencesRequest
+	51,  // 123: api.v2.EventService.ImportEvents:input_type -> api.v2.ImportEventsRequest
+	57,  // 124: api.v2.EventService.AssignRotation:input_type -> api.v2.AssignRotationRequest
+	60,  // 125: api.v2.EventService.GetWorkload:input_type -> api.v2.GetWorkloadRequest
+	7,   // 126: api.v2.HouseholdService.CreateHousehold:output_type -> api.v2.HouseholdResponse
+	7,   // 127: api.v2.HouseholdService.GetHousehold:output_type -> api.v2.HouseholdResponse
+	9,   // 128: api.v2.HouseholdService.GetHouseholds:output_type -> api.v2.HouseholdsResponse
+	7,   // 129: api.v2.HouseholdService.UpdateHousehold:output_type -> api.v2.HouseholdResponse
+	65,  // 130: api.v2.HouseholdService.DeleteHousehold:output_type -> google.protobuf.Empty
+	14,  // 131: api.v2.MemberService.CreateMember:output_type -> api.v2.MemberResponse
+	14,  // 132: api.v2.MemberService.GetMember:output_type -> api.v2.MemberResponse
+	15,  // 133: api.v2.MemberService.GetMembers:output_type -> api.v2.MembersResponse
+	14,  // 134: api.v2.MemberService.UpdateMember:output_type -> api.v2.MemberResponse
+	65,  // 135: api.v2.MemberService.DeleteMember:output_type -> google.protobuf.Empty
+	20,  // 136: api.v2.MealService.CreateMeal:output_type -> api.v2.MealResponse
+	20,  // 137: api.v2.MealService.GetMeal:output_type -> api.v2.MealResponse
+	21,  // 138: api.v2.MealService.GetMeals:output_type -> api.v2.MealsResponse
+	20,  // 139: api.v2.MealService.UpdateMeal:output_type -> api.v2.MealResponse
+	65,  // 140: api.v2.MealService.DeleteMeal:output_type -> google.protobuf.Empty
+	27,  // 141: api.v2.MealService.CreateRecipe:output_type -> api.v2.RecipeResponse
+	27,  // 142: api.v2.MealService.GetRecipe:output_type -> api.v2.RecipeResponse
+	28,  // 143: api.v2.MealService.GetRecipes:output_type -> api.v2.RecipesResponse
+	27,  // 144: api.v2.MealService.UpdateRecipe:output_type -> api.v2.RecipeResponse
+	65,  // 145: api.v2.MealService.DeleteRecipe:output_type -> google.protobuf.Empty
+	33,  // 146: api.v2.MealService.CreateMealPlanEntry:output_type -> api.v2.MealPlanEntryResponse
+	33,  // 147: api.v2.MealService.GetMealPlanEntry:output_type -> api.v2.MealPlanEntryResponse
+	34,  // 148: api.v2.MealService.GetMealPlan:output_type -> api.v2.MealPlanResponse
+	33,  // 149: api.v2.MealService.UpdateMealPlanEntry:output_type -> api.v2.MealPlanEntryResponse
+	65,  // 150: api.v2.MealService.DeleteMealPlanEntry:output_type -> google.protobuf.Empty
+	42,  // 151: api.v2.ShoppingListService.CreateShoppingList:output_type -> api.v2.ShoppingListResponse
+	42,  // 152: api.v2.ShoppingListService.GetShoppingList:output_type -> api.v2.ShoppingListResponse
+	43,  // 153: api.v2.ShoppingListService.GetShoppingLists:output_type -> api.v2.ShoppingListsResponse
+	42,  // 154: api.v2.ShoppingListService.RefreshShoppingList:output_type -> api.v2.ShoppingListResponse
+	65,  // 155: api.v2.ShoppingListService.DeleteShoppingList:output_type -> google.protobuf.Empty
+	42,  // 156: api.v2.ShoppingListService.AddShoppingItem:output_type -> api.v2.ShoppingListResponse
+	42,  // 157: api.v2.ShoppingListService.CheckShoppingItem:output_type -> api.v2.ShoppingListResponse
+	65,  // 158: api.v2.ShoppingListService.DeleteShoppingItem:output_type -> google.protobuf.Empty
+	48,  // 159: api.v2.EventService.CreateEvent:output_type -> api.v2.EventResponse
+	48,  // 160: api.v2.EventService.GetEvent:output_type -> api.v2.EventResponse
+	50,  // 161: api.v2.EventService.GetEvents:output_type -> api.v2.EventsResponse
+	48,  // 162: api.v2.EventService.UpdateEvent:output_type -> api.v2.EventResponse
+	65,  // 163: api.v2.EventService.DeleteEvent:output_type -> google.protobuf.Empty
+	50,  // 164: api.v2.EventService.ListOccurrences:output_type -> api.v2.EventsResponse
+	54,  // 165: api.v2.EventService.ImportEvents:output_type -> api.v2.ImportEventsResponse
+	59,  // 166: api.v2.EventService.AssignRotation:output_type -> api.v2.AssignRotationResponse
+	62,  // 167: api.v2.EventService.GetWorkload:output_type -> api.v2.WorkloadResponse
+	126, // [126:168] is the sub-list for method output_type
+	84,  // [84:126] is the sub-list for method input_type
+	84,  // [84:84] is the sub-list for extension type_name
+	84,  // [84:84] is the sub-list for extension extendee
+	0,   // [0:84] is the sub-list for field type_name
+}
+
+func init() { file_v2_hmly_proto_init() }
+func file_v2_hmly_proto_init() {
+	if File_v2_hmly_proto != nil {
+		return
+	}
+	file_v2_hmly_proto_msgTypes[3].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[4].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[6].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[11].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[12].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[17].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[18].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[22].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[24].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[25].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[28].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[30].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[31].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[35].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[39].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[40].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[43].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[45].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[46].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[47].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[48].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[51].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[54].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[56].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[57].OneofWrappers = []any{}
+	file_v2_hmly_proto_msgTypes[59].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_hmly_proto_rawDesc), len(file_v2_hmly_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   60,
+			NumExtensions: 0,
+			NumServices:   5,
+		},
+		GoTypes:           file_v2_hmly_proto_goTypes,
+		DependencyIndexes: file_v2_hmly_proto_depIdxs,
+		EnumInfos:         file_v2_hmly_proto_enumTypes,
+		MessageInfos:      file_v2_hmly_proto_msgTypes,
+	}.Build()
+	File_v2_hmly_proto = out.File
+	file_v2_hmly_proto_goTypes = nil
+	file_v2_hmly_proto_depIdxs = nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// dialect captures the SQL differences between the drivers the repository
+// runs on, so services can use Postgres in production and SQLite locally.
+type dialect struct {
+	name      string
+	returning bool
+}
+
+func dialectOf(db *gorm.DB) dialect {
+	return dialect{
+		name:      db.Dialector.Name(),
+		returning: slices.Contains(db.Callback().Update().Clauses, "RETURNING"),
+	}
+}
+
+// caseInsensitiveLike returns the operator for case-insensitive pattern
+// matching. SQLite's LIKE already ignores ASCII case.
+func (d dialect) caseInsensitiveLike() string {
+	if d.name == "postgres" {
+		return "ILIKE"
+	}
+	return "LIKE"
+}
+
+// translateError maps driver errors to GORM's, such as
+// gorm.ErrForeignKeyViolated, whether or not db was opened with
+// TranslateError. The SQLite driver only knows the code of deferred foreign
+// key checks; an ON DELETE RESTRICT violation is told by its message.
+func translateError(db *gorm.DB, err error) error {
+	if err == nil {
+		return nil
+	}
+	if db.Dialector.Name() == "sqlite" && err.Error() == "FOREIGN KEY constraint failed" {
+		return gorm.ErrForeignKeyViolated
+	}
+	if t, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		return t.Translate(err)
+	}
+	return err
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike makes user input match literally inside a LIKE pattern.
+func escapeLike(term string) string {
+	return likeEscaper.Replace(term)
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hmlylab/common/database"
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+)
+
+// EventFilter selects events. Empty fields match everything.
+type EventFilter struct {
+	// From and To are the window events must overlap, [From, To). Either may
+	// be zero for an open-ended window.
+	From, To time.Time
+	// Location is the time zone whose days all-day events cover; nil is UTC.
+	Location *time.Location
+
+	HouseholdID string
+	EntityType  string
+	EntityID    string
+	AssignedTo  string
+	// ExternalUIDs, if set, selects the events imported with these UIDs.
+	ExternalUIDs []string
+	// SeriesID, if set, selects that series and its overrides.
+	SeriesID string
+
+	Limit, Offset int
+}
+
+type EventRepository interface {
+	Repository[domain.Event]
+	// Find returns the stored events matching filter, ordered by start. With
+	// a window it also returns the recurring series that start before it
+	// ends and their overrides, for domain.ExpandEvents.
+	Find(ctx context.Context, filter EventFilter) ([]domain.Event, error)
+	// Occurrences expands the events matching filter into the occurrences
+	// that overlap its window, which must be bounded, with all-day events in
+	// filter.Location. Limit and Offset page through the occurrences.
+	Occurrences(ctx context.Context, filter EventFilter) ([]domain.Occurrence, error)
+}
+
+var ErrUnboundedWindow = fmt.Errorf("%w: event window needs both a start and an end", domain.ErrInvalidArgument)
+
+type eventRepository struct {
+	*repository[domain.Event]
+}
+
+func NewEventRepository(db *gorm.DB) EventRepository {
+	return NewEventRepositoryWithResolver(database.NewResolver(db))
+}
+
+func NewEventRepositoryWithResolver(resolver *database.Resolver) EventRepository {
+	return &eventRepository{&repository[domain.Event]{resolver: resolver, dialect: dialectOf(resolver.Primary())}}
+}
+
+func (r *eventRepository) Find(ctx context.Context, filter EventFilter) ([]domain.Event, error) {
+	var events []domain.Event
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		q := r.query(db, filter).Order("start_date")
+		if filter.Limit > 0 {
+			q = q.Limit(filter.Limit)
+		}
+		if filter.Offset > 0 {
+			q = q.Offset(filter.Offset)
+		}
+		return q.Find(&events).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return events, nil
+}
+
+func (r *eventRepository) Occurrences(ctx context.Context, filter EventFilter) ([]domain.Occurrence, error) {
+	if filter.From.IsZero() || filter.To.IsZero() {
+		return nil, ErrUnboundedWindow
+	}
+	page := filter
+	page.Limit, page.Offset = 0, 0
+	events, err := r.Find(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i] = events[i].In(filter.Location)
+	}
+	occurrences, err := domain.ExpandEvents(events, filter.From, filter.To)
+	if err != nil {
+		return nil, err
+	}
+	if filter.Offset > 0 {
+		occurrences = occurrences[min(filter.Offset, len(occurrences)):]
+	}
+	if filter.Limit > 0 && filter.Limit < len(occurrences) {
+		occurrences = occurrences[:filter.Limit]
+	}
+	return occurrences, nil
+}
+
+// farFuture stands in for an open window end.
+var farFuture = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (r *eventRepository) query(db *gorm.DB, f EventFilter) *gorm.DB {
+	q := db.Model(&domain.Event{})
+	for _, eq := range []struct{ column, value string }{
+		{"household_id", f.HouseholdID},
+		{"entity_type", f.EntityType},
+		{"entity_id", f.EntityID},
+		{"assigned_to", f.AssignedTo},
+	} {
+		if eq.value != "" {
+			q = q.Where(eq.column+" = ?", eq.value)
+		}
+	}
+	if f.SeriesID != "" {
+		q = q.Where("(id = ? OR series_id = ?)", f.SeriesID, f.SeriesID)
+	}
+	if len(f.ExternalUIDs) > 0 {
+		q = q.Where("external_uid IN ?", f.ExternalUIDs)
+	}
+	if f.From.IsZero() && f.To.IsZero() {
+		return q
+	}
+
+	from, to := f.From.UTC(), farFuture
+	if !f.To.IsZero() {
+		to = f.To.UTC()
+	}
+	// All-day events are stored as UTC dates, so they are compared with the
+	// window's wall clock times in f.Location.
+	dayFrom, dayTo := wallClock(from, f.Location), wallClock(to, f.Location)
+	if f.To.IsZero() {
+		dayTo = farFuture
+	}
+	seriesEnd := to
+	if dayTo.After(seriesEnd) {
+		seriesEnd = dayTo
+	}
+
+	overlap := "all_day = ? AND start_date < ? AND (end_date > ? OR start_date >= ?)"
+	window := db.Session(&gorm.Session{NewDB: true}).
+		Where(overlap, false, to, from, from).
+		Or(overlap, true, dayTo, dayFrom, dayFrom).
+		Or("rrule <> '' AND start_date < ?", seriesEnd).
+		Or("series_id <> '' AND recurrence_id < ?", seriesEnd)
+	return q.Where(window)
+}
+
+// wallClock returns the wall clock time of t in loc as a UTC time.
+func wallClock(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return t.UTC()
+	}
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
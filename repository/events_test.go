@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupEventRepository(t *testing.T, events ...domain.Event) EventRepository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.Event{}))
+	repo := NewEventRepository(db)
+	for i := range events {
+		_, err := repo.Create(context.Background(), &events[i])
+		require.NoError(t, err)
+	}
+	return repo
+}
+
+func at(d, h int) time.Time {
+	return time.Date(2025, 5, d, h, 0, 0, 0, time.UTC)
+}
+
+func names(events []domain.Event) []string {
+	var out []string
+	for _, e := range events {
+		out = append(out, e.Name)
+	}
+	return out
+}
+
+func TestEventRepository_FindWindow(t *testing.T) {
+	repo := setupEventRepository(t,
+		domain.Event{Name: "before", HouseholdID: "h1", StartDate: at(5, 8), EndDate: at(5, 9)},
+		domain.Event{Name: "spanning", HouseholdID: "h1", StartDate: at(4, 20), EndDate: at(6, 8)},
+		domain.Event{Name: "inside", HouseholdID: "h1", AssignedTo: "u1", StartDate: at(6, 12), EndDate: at(6, 13)},
+		domain.Event{Name: "reminder", HouseholdID: "h1", StartDate: at(6, 9), EndDate: at(6, 9)},
+		domain.Event{Name: "after", HouseholdID: "h1", StartDate: at(7, 9), EndDate: at(7, 10)},
+		domain.Event{Name: "other household", HouseholdID: "h2", StartDate: at(6, 12), EndDate: at(6, 13)},
+	)
+	ctx := context.Background()
+
+	events, err := repo.Find(ctx, EventFilter{HouseholdID: "h1", From: at(6, 0), To: at(7, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"spanning", "reminder", "inside"}, names(events))
+
+	events, err = repo.Find(ctx, EventFilter{HouseholdID: "h1", From: at(6, 0), To: at(7, 0), AssignedTo: "u1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"inside"}, names(events))
+
+	events, err = repo.Find(ctx, EventFilter{From: at(6, 10)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"inside", "other household", "after"}, names(events))
+
+	events, err = repo.Find(ctx, EventFilter{To: at(5, 12), Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"spanning"}, names(events))
+}
+
+func TestEventRepository_FindEntity(t *testing.T) {
+	repo := setupEventRepository(t,
+		domain.Event{Name: "dinner", EntityType: "meal", EntityID: "m1", StartDate: at(6, 18), EndDate: at(6, 19)},
+		domain.Event{Name: "lunch", EntityType: "meal", EntityID: "m2", StartDate: at(6, 12), EndDate: at(6, 13)},
+		domain.Event{Name: "bins", EntityType: "chore", EntityID: "m1", StartDate: at(6, 7), EndDate: at(6, 8)},
+	)
+
+	events, err := repo.Find(context.Background(), EventFilter{EntityType: "meal", EntityID: "m1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"dinner"}, names(events))
+}
+
+func TestEventRepository_FindExternalUIDs(t *testing.T) {
+	repo := setupEventRepository(t,
+		domain.Event{Name: "imported", HouseholdID: "h1", ExternalUID: "a@example.com", StartDate: at(6, 9), EndDate: at(6, 10)},
+		domain.Event{Name: "other", HouseholdID: "h1", ExternalUID: "b@example.com", StartDate: at(6, 9), EndDate: at(6, 10)},
+		domain.Event{Name: "other household", HouseholdID: "h2", ExternalUID: "a@example.com", StartDate: at(6, 9), EndDate: at(6, 10)},
+		domain.Event{Name: "local", HouseholdID: "h1", StartDate: at(6, 9), EndDate: at(6, 10)},
+	)
+
+	events, err := repo.Find(context.Background(), EventFilter{HouseholdID: "h1", ExternalUIDs: []string{"a@example.com"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"imported"}, names(events))
+}
+
+func TestEventRepository_FindSeries(t *testing.T) {
+	rid := at(13, 9)
+	repo := setupEventRepository(t,
+		domain.Event{Name: "swim", HouseholdID: "h1", StartDate: at(6, 9), EndDate: at(6, 10), RRule: "FREQ=WEEKLY"},
+		domain.Event{Name: "other", HouseholdID: "h1", StartDate: at(6, 9), EndDate: at(6, 10)},
+	)
+	ctx := context.Background()
+	all, err := repo.Find(ctx, EventFilter{})
+	require.NoError(t, err)
+	seriesID := all[0].ID
+	if all[0].Name != "swim" {
+		seriesID = all[1].ID
+	}
+	_, err = repo.Create(ctx, &domain.Event{Name: "late swim", HouseholdID: "h1", SeriesID: seriesID, RecurrenceID: &rid, StartDate: at(13, 11), EndDate: at(13, 12)})
+	require.NoError(t, err)
+
+	events, err := repo.Find(ctx, EventFilter{SeriesID: seriesID, From: at(13, 0), To: at(14, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"swim", "late swim"}, names(events))
+}
+
+func TestEventRepository_AllDayInTimeZone(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	repo := setupEventRepository(t,
+		domain.Event{Name: "holiday", AllDay: true, StartDate: at(1, 0), EndDate: at(2, 0)},
+	)
+	ctx := context.Background()
+	tokyoAt := func(d, h int) time.Time { return time.Date(2025, 5, d, h, 0, 0, 0, tokyo) }
+
+	// 8am on 1 May in Tokyo is still 30 April in UTC.
+	events, err := repo.Find(ctx, EventFilter{From: tokyoAt(1, 8), To: tokyoAt(1, 9), Location: tokyo})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"holiday"}, names(events))
+
+	// 2 May in Tokyo starts while it is 1 May in UTC.
+	events, err = repo.Find(ctx, EventFilter{From: tokyoAt(2, 0), To: tokyoAt(2, 9), Location: tokyo})
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	occurrences, err := repo.Occurrences(ctx, EventFilter{From: tokyoAt(1, 0), To: tokyoAt(3, 0), Location: tokyo})
+	assert.NoError(t, err)
+	if assert.Len(t, occurrences, 1) {
+		assert.True(t, occurrences[0].Event.StartDate.Equal(tokyoAt(1, 0)))
+		assert.True(t, occurrences[0].Event.EndDate.Equal(tokyoAt(2, 0)))
+	}
+}
+
+func TestEventRepository_Occurrences(t *testing.T) {
+	repo := setupEventRepository(t,
+		domain.Event{Name: "bins", HouseholdID: "h1", StartDate: at(5, 19), EndDate: at(5, 20), RRule: "FREQ=DAILY"},
+		domain.Event{Name: "party", HouseholdID: "h1", StartDate: at(20, 19), EndDate: at(20, 23)},
+	)
+	ctx := context.Background()
+
+	series, err := repo.Find(ctx, EventFilter{HouseholdID: "h1", From: at(10, 0), To: at(11, 0)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bins"}, names(series))
+
+	// Move the 10 May bins outside the window.
+	override, err := series[0].OverrideOccurrence(at(10, 19))
+	require.NoError(t, err)
+	override.StartDate, override.EndDate = at(12, 19), at(12, 20)
+	_, err = repo.Create(ctx, &override)
+	require.NoError(t, err)
+
+	occurrences, err := repo.Occurrences(ctx, EventFilter{HouseholdID: "h1", From: at(9, 0), To: at(12, 0)})
+	assert.NoError(t, err)
+	var starts []time.Time
+	for _, occ := range occurrences {
+		starts = append(starts, occ.Event.StartDate.UTC())
+	}
+	assert.Equal(t, []time.Time{at(9, 19), at(11, 19)}, starts)
+
+	occurrences, err = repo.Occurrences(ctx, EventFilter{HouseholdID: "h1", From: at(9, 0), To: at(21, 0), Offset: 1, Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 2)
+	assert.True(t, occurrences[0].Event.StartDate.Equal(at(11, 19)))
+
+	_, err = repo.Occurrences(ctx, EventFilter{From: at(9, 0)})
+	assert.ErrorIs(t, err, ErrUnboundedWindow)
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/database"
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RecipeFilter selects recipes. Empty fields match everything.
+type RecipeFilter struct {
+	HouseholdID string
+	// Tag selects recipes with this tag.
+	Tag string
+	// Search matches recipe names case-insensitively.
+	Search string
+
+	Limit, Offset int
+}
+
+// RecipeRepository stores recipes with their ingredients: Get and Find load
+// them, Create and Update save them, and Update replaces the stored list.
+// Delete fails with domain.ErrRecipeInUse while meal plan entries use the
+// recipe.
+type RecipeRepository interface {
+	Repository[domain.Recipe]
+	// Find returns the recipes matching filter ordered by name.
+	Find(ctx context.Context, filter RecipeFilter) ([]domain.Recipe, error)
+}
+
+type recipeRepository struct {
+	*repository[domain.Recipe]
+}
+
+func NewRecipeRepository(db *gorm.DB) RecipeRepository {
+	return NewRecipeRepositoryWithResolver(database.NewResolver(db))
+}
+
+func NewRecipeRepositoryWithResolver(resolver *database.Resolver) RecipeRepository {
+	return &recipeRepository{&repository[domain.Recipe]{resolver: resolver, dialect: dialectOf(resolver.Primary())}}
+}
+
+func preloadIngredients(db *gorm.DB) *gorm.DB {
+	return db.Preload("Ingredients", func(db *gorm.DB) *gorm.DB { return db.Order("position") })
+}
+
+func (r *recipeRepository) Get(ctx context.Context, id string) (*domain.Recipe, error) {
+	var recipe domain.Recipe
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return preloadIngredients(db).First(&recipe, "id = ?", id).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return &recipe, nil
+}
+
+func (r *recipeRepository) Find(ctx context.Context, filter RecipeFilter) ([]domain.Recipe, error) {
+	var recipes []domain.Recipe
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		q := preloadIngredients(db).Model(&domain.Recipe{}).Order("name")
+		if filter.HouseholdID != "" {
+			q = q.Where("household_id = ?", filter.HouseholdID)
+		}
+		if filter.Tag != "" {
+			// Tags are stored as a JSON array, so the quoted tag matches
+			// only whole tags.
+			tag, err := json.Marshal(filter.Tag)
+			if err != nil {
+				return err
+			}
+			q = q.Where(`tags LIKE ? ESCAPE '\'`, "%"+escapeLike(string(tag))+"%")
+		}
+		if filter.Search != "" {
+			q = q.Where(fmt.Sprintf(`name %s ? ESCAPE '\'`, r.dialect.caseInsensitiveLike()), "%"+escapeLike(filter.Search)+"%")
+		}
+		if filter.Limit > 0 {
+			q = q.Limit(filter.Limit)
+		}
+		if filter.Offset > 0 {
+			q = q.Offset(filter.Offset)
+		}
+		return q.Find(&recipes).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return recipes, nil
+}
+
+func (r *recipeRepository) Update(ctx context.Context, id string, recipe *domain.Recipe) (*domain.Recipe, error) {
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		if err := db.First(&domain.Recipe{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		if err := db.Where("recipe_id = ?", id).Delete(&domain.Ingredient{}).Error; err != nil {
+			return err
+		}
+		recipe.ID = id
+		for i := range recipe.Ingredients {
+			recipe.Ingredients[i].RecipeID = id
+		}
+		return db.Save(recipe).Error
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return recipe, nil
+}
+
+// Delete removes the recipe with its ingredients.
+func (r *recipeRepository) Delete(ctx context.Context, id string) error {
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		var entries int64
+		if err := db.Model(&domain.MealPlanEntry{}).Where("recipe_id = ?", id).Count(&entries).Error; err != nil {
+			return err
+		}
+		if entries > 0 {
+			return fmt.Errorf("%w: %d meal plan entries", domain.ErrRecipeInUse, entries)
+		}
+		if err := db.Where("recipe_id = ?", id).Delete(&domain.Ingredient{}).Error; err != nil {
+			return err
+		}
+		// The foreign key restricts deletes too, which catches entries added
+		// since the count.
+		err := db.Delete(&domain.Recipe{}, "id = ?", id).Error
+		if errors.Is(translateError(db, err), gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: meal plan entries refer to it", domain.ErrRecipeInUse)
+		}
+		return err
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+	}
+	return err
+}
+
+// MealPlanFilter selects meal plan entries. Empty fields match everything.
+type MealPlanFilter struct {
+	// From and To are the dates of the plan, [From, To); either may be zero.
+	// Only their dates count.
+	From, To time.Time
+
+	HouseholdID string
+	Slot        domain.MealSlot
+	RecipeID    string
+
+	Limit, Offset int
+}
+
+// MealPlanRepository stores meal plan entries. Get and Find load each
+// entry's recipe and its ingredients; saving an entry never saves its recipe.
+type MealPlanRepository interface {
+	Repository[domain.MealPlanEntry]
+	// Find returns the entries matching filter by date and then slot.
+	Find(ctx context.Context, filter MealPlanFilter) ([]domain.MealPlanEntry, error)
+}
+
+type mealPlanRepository struct {
+	*repository[domain.MealPlanEntry]
+}
+
+func NewMealPlanRepository(db *gorm.DB) MealPlanRepository {
+	return NewMealPlanRepositoryWithResolver(database.NewResolver(db))
+}
+
+func NewMealPlanRepositoryWithResolver(resolver *database.Resolver) MealPlanRepository {
+	return &mealPlanRepository{&repository[domain.MealPlanEntry]{resolver: resolver, dialect: dialectOf(resolver.Primary())}}
+}
+
+func preloadRecipe(db *gorm.DB) *gorm.DB {
+	return db.Preload("Recipe").Preload("Recipe.Ingredients", func(db *gorm.DB) *gorm.DB { return db.Order("position") })
+}
+
+// slotOrder sorts entries by domain.MealSlots.
+var slotOrder = func() string {
+	var b strings.Builder
+	b.WriteString("CASE slot")
+	for i, s := range domain.MealSlots {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", s, i)
+	}
+	b.WriteString(" END")
+	return b.String()
+}()
+
+func (r *mealPlanRepository) Create(ctx context.Context, entry *domain.MealPlanEntry) (*domain.MealPlanEntry, error) {
+	if err := r.resolver.Writer(ctx).Omit(clause.Associations).Create(entry).Error; err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (r *mealPlanRepository) Get(ctx context.Context, id string) (*domain.MealPlanEntry, error) {
+	var entry domain.MealPlanEntry
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return preloadRecipe(db).First(&entry, "id = ?", id).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *mealPlanRepository) Find(ctx context.Context, filter MealPlanFilter) ([]domain.MealPlanEntry, error) {
+	var entries []domain.MealPlanEntry
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		q := preloadRecipe(db).Model(&domain.MealPlanEntry{}).Order("date").Order(slotOrder)
+		for _, eq := range []struct{ column, value string }{
+			{"household_id", filter.HouseholdID},
+			{"slot", string(filter.Slot)},
+			{"recipe_id", filter.RecipeID},
+		} {
+			if eq.value != "" {
+				q = q.Where(eq.column+" = ?", eq.value)
+			}
+		}
+		if !filter.From.IsZero() {
+			q = q.Where("date >= ?", domain.AllDayDate(filter.From))
+		}
+		if !filter.To.IsZero() {
+			q = q.Where("date < ?", domain.AllDayDate(filter.To))
+		}
+		if filter.Limit > 0 {
+			q = q.Limit(filter.Limit)
+		}
+		if filter.Offset > 0 {
+			q = q.Offset(filter.Offset)
+		}
+		return q.Find(&entries).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *mealPlanRepository) Update(ctx context.Context, id string, entry *domain.MealPlanEntry) (*domain.MealPlanEntry, error) {
+	db := r.resolver.Writer(ctx)
+	if err := db.First(&domain.MealPlanEntry{}, "id = ?", id).Error; err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	entry.ID = id
+	if err := db.Omit(clause.Associations).Save(entry).Error; err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return entry, nil
+}
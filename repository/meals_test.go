@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupMealRepositories(t *testing.T) (RecipeRepository, MealPlanRepository) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.Recipe{}, &domain.Ingredient{}, &domain.MealPlanEntry{}))
+	return NewRecipeRepository(db), NewMealPlanRepository(db)
+}
+
+func pancakes() *domain.Recipe {
+	return &domain.Recipe{
+		HouseholdID: "h1", Name: "Pancakes", Servings: 4,
+		Steps: domain.StringList{"Whisk, then rest", "Fry"},
+		Tags:  domain.StringList{"breakfast", "vegetarian"},
+		Ingredients: []domain.Ingredient{
+			{Name: "flour", Quantity: 200, Unit: "g"},
+			{Name: "milk", Quantity: 300, Unit: "ml"},
+			{Name: "salt"},
+		},
+	}
+}
+
+func ingredientNames(r domain.Recipe) []string {
+	var out []string
+	for _, in := range r.Ingredients {
+		out = append(out, in.Name)
+	}
+	return out
+}
+
+func TestRecipeRepository_CRUD(t *testing.T) {
+	recipes, _ := setupMealRepositories(t)
+	ctx := context.Background()
+
+	created, err := recipes.Create(ctx, pancakes())
+	require.NoError(t, err)
+
+	got, err := recipes.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flour", "milk", "salt"}, ingredientNames(*got))
+	assert.Equal(t, domain.StringList{"Whisk, then rest", "Fry"}, got.Steps)
+	assert.Equal(t, 2, got.Ingredients[2].Position)
+
+	got.Ingredients = []domain.Ingredient{{Name: "eggs", Quantity: 2}, got.Ingredients[0]}
+	got.Servings = 2
+	_, err = recipes.Update(ctx, got.ID, got)
+	require.NoError(t, err)
+	got, err = recipes.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eggs", "flour"}, ingredientNames(*got), "update replaces the ingredients")
+	assert.Equal(t, 2, got.Servings)
+
+	_, err = recipes.Update(ctx, "missing", pancakes())
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = recipes.Create(ctx, &domain.Recipe{Name: "Soup", Ingredients: []domain.Ingredient{{Name: "water", Quantity: -1}}})
+	assert.ErrorIs(t, err, domain.ErrInvalidRecipe)
+
+	require.NoError(t, recipes.Delete(ctx, created.ID))
+	_, err = recipes.Get(ctx, created.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestRecipeRepository_Find(t *testing.T) {
+	recipes, _ := setupMealRepositories(t)
+	ctx := context.Background()
+	for _, r := range []*domain.Recipe{
+		pancakes(),
+		{HouseholdID: "h1", Name: "Porridge", Tags: domain.StringList{"breakfast_bowl"}},
+		{HouseholdID: "h1", Name: "Chilli", Tags: domain.StringList{"dinner"}},
+		{HouseholdID: "h2", Name: "Pasta", Tags: domain.StringList{"breakfast"}},
+	} {
+		_, err := recipes.Create(ctx, r)
+		require.NoError(t, err)
+	}
+
+	found, err := recipes.Find(ctx, RecipeFilter{HouseholdID: "h1"})
+	require.NoError(t, err)
+	require.Len(t, found, 3)
+	assert.Equal(t, "Chilli", found[0].Name)
+	assert.Len(t, found[1].Ingredients, 3)
+
+	found, err = recipes.Find(ctx, RecipeFilter{HouseholdID: "h1", Tag: "breakfast"})
+	require.NoError(t, err)
+	require.Len(t, found, 1, "tags match whole")
+	assert.Equal(t, "Pancakes", found[0].Name)
+
+	found, err = recipes.Find(ctx, RecipeFilter{Search: "pa"})
+	require.NoError(t, err)
+	assert.Len(t, found, 2)
+}
+
+func TestRecipeRepository_DeleteRestrictedByForeignKey(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "meals.db")+"?_foreign_keys=on"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.Recipe{}, &domain.Ingredient{}, &domain.MealPlanEntry{}))
+	recipes, plan := NewRecipeRepository(db), NewMealPlanRepository(db)
+	ctx := context.Background()
+	recipe, err := recipes.Create(ctx, pancakes())
+	require.NoError(t, err)
+
+	// Add an entry right after Delete has counted none, as a concurrent
+	// request could.
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:add_entry", func(tx *gorm.DB) {
+		if tx.Statement.Table != "meal_plan_entries" {
+			return
+		}
+		day := time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC)
+		tx.Session(&gorm.Session{NewDB: true}).Exec("INSERT INTO meal_plan_entries (id, created_at, updated_at, household_id, date, slot, recipe_id) VALUES ('late', ?, ?, 'h1', ?, 'dinner', ?)", day, day, day, recipe.ID)
+	}))
+	err = recipes.Delete(ctx, recipe.ID)
+	assert.ErrorIs(t, err, domain.ErrRecipeInUse)
+	require.NoError(t, db.Callback().Query().Remove("test:add_entry"))
+
+	got, err := recipes.Get(ctx, recipe.ID)
+	require.NoError(t, err, "the recipe is kept")
+	assert.Len(t, got.Ingredients, 3, "with its ingredients")
+
+	entry, err := plan.Create(ctx, &domain.MealPlanEntry{HouseholdID: "h1", Date: time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC), Slot: domain.Lunch, RecipeID: recipe.ID})
+	require.NoError(t, err)
+	require.NoError(t, plan.Delete(ctx, entry.ID))
+	require.NoError(t, recipes.Delete(ctx, recipe.ID))
+}
+
+func TestMealPlanRepository(t *testing.T) {
+	recipes, plan := setupMealRepositories(t)
+	ctx := context.Background()
+	recipe, err := recipes.Create(ctx, pancakes())
+	require.NoError(t, err)
+
+	day := func(d int) time.Time { return time.Date(2025, 5, d, 0, 0, 0, 0, time.UTC) }
+	for _, e := range []domain.MealPlanEntry{
+		{HouseholdID: "h1", Date: day(6), Slot: domain.Dinner, RecipeID: recipe.ID},
+		{HouseholdID: "h1", Date: time.Date(2025, 5, 6, 7, 30, 0, 0, time.FixedZone("EST", -5*60*60)), Slot: domain.Breakfast, RecipeID: recipe.ID, Servings: 2},
+		{HouseholdID: "h1", Date: day(5), Slot: domain.Lunch, RecipeID: recipe.ID},
+		{HouseholdID: "h1", Date: day(12), Slot: domain.Lunch, RecipeID: recipe.ID},
+		{HouseholdID: "h2", Date: day(6), Slot: domain.Lunch, RecipeID: recipe.ID},
+	} {
+		_, err := plan.Create(ctx, &e)
+		require.NoError(t, err)
+	}
+	_, err = plan.Create(ctx, &domain.MealPlanEntry{HouseholdID: "h1", Date: day(6), Slot: "brunch", RecipeID: recipe.ID})
+	assert.ErrorIs(t, err, domain.ErrInvalidMealSlot)
+
+	entries, err := plan.Find(ctx, MealPlanFilter{HouseholdID: "h1", From: day(5), To: day(12)})
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	var slots []domain.MealSlot
+	for _, e := range entries {
+		slots = append(slots, e.Slot)
+	}
+	assert.Equal(t, []domain.MealSlot{domain.Lunch, domain.Breakfast, domain.Dinner}, slots, "by date, then slot")
+	assert.Equal(t, day(6), entries[1].Date.UTC(), "dates keep their own day")
+	require.NotNil(t, entries[1].Recipe)
+	assert.Equal(t, []string{"flour", "milk", "salt"}, ingredientNames(*entries[1].Recipe))
+
+	entry := entries[0]
+	entry.Recipe.Name = "Not saved"
+	entry.Servings = 6
+	_, err = plan.Update(ctx, entry.ID, &entry)
+	require.NoError(t, err)
+	got, err := plan.Get(ctx, entry.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 6, got.Servings)
+	assert.Equal(t, "Pancakes", got.Recipe.Name, "saving an entry leaves its recipe alone")
+
+	err = recipes.Delete(ctx, recipe.ID)
+	assert.ErrorIs(t, err, domain.ErrRecipeInUse)
+	entries, err = plan.Find(ctx, MealPlanFilter{})
+	require.NoError(t, err)
+	assert.Len(t, entries, 5, "a recipe in use is kept with its plan entries")
+	_, err = recipes.Get(ctx, recipe.ID)
+	require.NoError(t, err)
+
+	for _, e := range entries {
+		require.NoError(t, plan.Delete(ctx, e.ID))
+	}
+	require.NoError(t, recipes.Delete(ctx, recipe.ID))
+}
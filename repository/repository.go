@@ -2,14 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/hmlylab/common/database"
 	"github.com/hmlylab/common/logger"
 	"gorm.io/gorm"
-)
-
-var (
-	log = logger.NewLogger()
+	"gorm.io/gorm/clause"
 )
 
 type Repository[T any] interface {
@@ -21,17 +20,43 @@ type Repository[T any] interface {
 	Delete(ctx context.Context, id string) error
 }
 
+// Searcher finds models by a case-insensitive substring of one of their
+// fields. fieldName is a field or column of T; anything else fails with
+// ErrUnknownField.
+type Searcher[T any] interface {
+	Search(ctx context.Context, fieldName, term string, limit, offset int) ([]T, error)
+}
+
+var ErrUnknownField = errors.New("repository: unknown field")
+
 type repository[T any] struct {
-	DB *gorm.DB
+	resolver *database.Resolver
+	dialect  dialect
 }
 
 func NewRepository[T any](db *gorm.DB) Repository[T] {
-	return &repository[T]{DB: db}
+	return NewRepositoryWithResolver[T](database.NewResolver(db))
+}
+
+// NewRepositoryWithResolver sends Get, GetAll and GetAllByField to the
+// resolver's replicas and everything else to the primary. Use
+// database.WithPrimary or Resolver.Transaction to keep reads on the primary.
+func NewRepositoryWithResolver[T any](resolver *database.Resolver) Repository[T] {
+	return &repository[T]{resolver: resolver, dialect: dialectOf(resolver.Primary())}
+}
+
+func NewSearcher[T any](db *gorm.DB) Searcher[T] {
+	return NewSearcherWithResolver[T](database.NewResolver(db))
+}
+
+// NewSearcherWithResolver sends searches to the resolver's replicas.
+func NewSearcherWithResolver[T any](resolver *database.Resolver) Searcher[T] {
+	return &repository[T]{resolver: resolver, dialect: dialectOf(resolver.Primary())}
 }
 
 func (r *repository[T]) Create(ctx context.Context, model *T) (*T, error) {
-	if err := r.DB.Create(&model).Error; err != nil {
-		log.Error(err.Error())
+	if err := r.resolver.Writer(ctx).Create(&model).Error; err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
 		return nil, err
 	}
 	return model, nil
@@ -39,9 +64,11 @@ func (r *repository[T]) Create(ctx context.Context, model *T) (*T, error) {
 
 func (r *repository[T]) Get(ctx context.Context, id string) (*T, error) {
 	var model T
-	if err := r.DB.First(&model, "id = ?", id).Error; err != nil {
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return db.First(&model, "id = ?", id).Error
+	}); err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Error(err.Error())
+			logger.Default().ErrorContext(ctx, err.Error())
 			return nil, gorm.ErrRecordNotFound
 		}
 		return nil, err
@@ -50,9 +77,11 @@ func (r *repository[T]) Get(ctx context.Context, id string) (*T, error) {
 }
 func (r *repository[T]) GetAll(ctx context.Context, limit, offset int) ([]T, error) {
 	var models []T
-	if err := r.DB.Limit(limit).Offset(offset).Find(&models).Error; err != nil {
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return db.Limit(limit).Offset(offset).Find(&models).Error
+	}); err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Error(err.Error())
+			logger.Default().ErrorContext(ctx, err.Error())
 			return nil, gorm.ErrRecordNotFound
 		}
 		return nil, err
@@ -63,9 +92,11 @@ func (r *repository[T]) GetAll(ctx context.Context, limit, offset int) ([]T, err
 func (r *repository[T]) GetAllByField(ctx context.Context, fieldName, fieldValue string) ([]T, error) {
 	var models []T
 	var query = fmt.Sprintf("%s = ?", fieldName)
-	if err := r.DB.Find(&models, query, fieldValue).Error; err != nil {
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return db.Find(&models, query, fieldValue).Error
+	}); err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Error(err.Error())
+			logger.Default().ErrorContext(ctx, err.Error())
 			return nil, gorm.ErrRecordNotFound
 		}
 		return nil, err
@@ -73,16 +104,43 @@ func (r *repository[T]) GetAllByField(ctx context.Context, fieldName, fieldValue
 	return models, nil
 }
 
+// Search does a case-insensitive substring match on fieldName, which is
+// looked up in T's schema so only its own columns reach the query.
+func (r *repository[T]) Search(ctx context.Context, fieldName, term string, limit, offset int) ([]T, error) {
+	var models []T
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(new(T)); err != nil {
+			return err
+		}
+		field := stmt.Schema.LookUpField(fieldName)
+		if field == nil || field.DBName == "" {
+			return fmt.Errorf("%w %q", ErrUnknownField, fieldName)
+		}
+		query := fmt.Sprintf(`%s %s ? ESCAPE '\'`, stmt.Quote(field.DBName), r.dialect.caseInsensitiveLike())
+		return db.Limit(limit).Offset(offset).Find(&models, query, "%"+escapeLike(term)+"%").Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return models, nil
+}
+
 func (r *repository[T]) Update(ctx context.Context, id string, model *T) (*T, error) {
 	var existing T
-	if err := r.DB.First(&existing, "id = ?", id).Error; err != nil {
+	if err := r.resolver.Writer(ctx).First(&existing, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Error(err.Error())
+			logger.Default().ErrorContext(ctx, err.Error())
 			return nil, gorm.ErrRecordNotFound
 		}
 		return nil, err
 	}
-	if err := r.DB.Save(model).Error; err != nil {
+	db := r.resolver.Writer(ctx)
+	if r.dialect.returning {
+		// Read back what was stored, including values set by the database.
+		db = db.Clauses(clause.Returning{})
+	}
+	if err := db.Save(model).Error; err != nil {
 		return nil, err
 	}
 	return model, nil
@@ -90,9 +148,9 @@ func (r *repository[T]) Update(ctx context.Context, id string, model *T) (*T, er
 
 func (r *repository[T]) Delete(ctx context.Context, id string) error {
 	var existing T
-	if err := r.DB.Delete(&existing, "id = ?", id).Error; err != nil {
+	if err := r.resolver.Writer(ctx).Delete(&existing, "id = ?", id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
-			log.Error(err.Error())
+			logger.Default().ErrorContext(ctx, err.Error())
 			return gorm.ErrRecordNotFound
 		}
 		return err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hmlylab/common/database"
 	"github.com/stretchr/testify/assert"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -64,3 +65,75 @@ func TestRepository_Get_NotFound(t *testing.T) {
 	_, err := repo.Get(ctx, "not-exist")
 	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
+
+func TestSearcher_Search(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewSearcher[TestModel](db)
+	ctx := context.Background()
+
+	for _, m := range []*TestModel{
+		{ID: "1", Name: "Smith Family"},
+		{ID: "2", Name: "the smiths"},
+		{ID: "3", Name: "Jones"},
+		{ID: "4", Name: "100% Jones"},
+	} {
+		assert.NoError(t, db.Create(m).Error)
+	}
+
+	found, err := repo.Search(ctx, "name", "SMITH", 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+
+	found, err = repo.Search(ctx, "name", "100%", 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1, "wildcards in the term should match literally")
+
+	found, err = repo.Search(ctx, "name", "jones", 1, 0)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+
+	found, err = repo.Search(ctx, "Name", "jones", 10, 0)
+	assert.NoError(t, err)
+	assert.Len(t, found, 2, "fields can be named as in the struct")
+
+	_, err = repo.Search(ctx, "name = name OR 1", "x", 10, 0)
+	assert.ErrorIs(t, err, ErrUnknownField)
+}
+
+func TestDialect(t *testing.T) {
+	db := setupTestDB(t)
+	d := dialectOf(db)
+	assert.Equal(t, "sqlite", d.name)
+	assert.Equal(t, "LIKE", d.caseInsensitiveLike())
+	assert.Equal(t, "ILIKE", dialect{name: "postgres"}.caseInsensitiveLike())
+	assert.Equal(t, `50\% off\_now`, escapeLike("50% off_now"))
+}
+
+func TestRepository_ReadsFromReplica(t *testing.T) {
+	primary := setupTestDB(t)
+	replica := setupTestDB(t)
+	assert.NoError(t, replica.Create(&TestModel{ID: "1", Name: "from replica"}).Error)
+
+	resolver := database.NewResolver(primary, replica)
+	repo := NewRepositoryWithResolver[TestModel](resolver)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, &TestModel{ID: "1", Name: "from primary"})
+	assert.NoError(t, err)
+	assert.Equal(t, "from primary", created.Name)
+
+	got, err := repo.Get(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "from replica", got.Name)
+
+	got, err = repo.Get(database.WithPrimary(ctx), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "from primary", got.Name)
+
+	err = resolver.Transaction(ctx, func(ctx context.Context) error {
+		all, err := repo.GetAllByField(ctx, "name", "from primary")
+		assert.Len(t, all, 1)
+		return err
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/hmlylab/common/database"
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ShoppingListFilter selects shopping lists. Empty fields match everything.
+type ShoppingListFilter struct {
+	HouseholdID string
+
+	Limit, Offset int
+}
+
+// ShoppingListRepository stores shopping lists with their items: Get and
+// Find load them, Create and Update save them, and Update replaces the stored
+// items, keeping the IDs of those still on the list. The item methods change
+// one item of a list without rewriting it.
+type ShoppingListRepository interface {
+	Repository[domain.ShoppingList]
+	// Find returns the lists matching filter, latest first.
+	Find(ctx context.Context, filter ShoppingListFilter) ([]domain.ShoppingList, error)
+	// AddItem appends item to the list listID.
+	AddItem(ctx context.Context, listID string, item *domain.ShoppingItem) (*domain.ShoppingItem, error)
+	// CheckItem checks the item off for userID, or back on if not checked.
+	CheckItem(ctx context.Context, listID, itemID string, checked bool, userID string) (*domain.ShoppingItem, error)
+	DeleteItem(ctx context.Context, listID, itemID string) error
+}
+
+type shoppingListRepository struct {
+	*repository[domain.ShoppingList]
+}
+
+func NewShoppingListRepository(db *gorm.DB) ShoppingListRepository {
+	return NewShoppingListRepositoryWithResolver(database.NewResolver(db))
+}
+
+func NewShoppingListRepositoryWithResolver(resolver *database.Resolver) ShoppingListRepository {
+	return &shoppingListRepository{&repository[domain.ShoppingList]{resolver: resolver, dialect: dialectOf(resolver.Primary())}}
+}
+
+func preloadItems(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB { return db.Order("position") })
+}
+
+func (r *shoppingListRepository) Get(ctx context.Context, id string) (*domain.ShoppingList, error) {
+	var list domain.ShoppingList
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		return preloadItems(db).First(&list, "id = ?", id).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (r *shoppingListRepository) Find(ctx context.Context, filter ShoppingListFilter) ([]domain.ShoppingList, error) {
+	var lists []domain.ShoppingList
+	if err := r.resolver.Read(ctx, func(db *gorm.DB) error {
+		q := preloadItems(db).Model(&domain.ShoppingList{}).Order("start_date DESC").Order("created_at DESC")
+		if filter.HouseholdID != "" {
+			q = q.Where("household_id = ?", filter.HouseholdID)
+		}
+		if filter.Limit > 0 {
+			q = q.Limit(filter.Limit)
+		}
+		if filter.Offset > 0 {
+			q = q.Offset(filter.Offset)
+		}
+		return q.Find(&lists).Error
+	}); err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (r *shoppingListRepository) Update(ctx context.Context, id string, list *domain.ShoppingList) (*domain.ShoppingList, error) {
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		if err := db.First(&domain.ShoppingList{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		var stored []string
+		if err := db.Model(&domain.ShoppingItem{}).Where("shopping_list_id = ?", id).Pluck("id", &stored).Error; err != nil {
+			return err
+		}
+		existing := make(map[string]bool, len(stored))
+		for _, itemID := range stored {
+			existing[itemID] = true
+		}
+
+		// Items keep their IDs: the ones still on the list are updated in
+		// place, new ones are created and only the rest are deleted.
+		list.ID = id
+		kept := []string{}
+		for i := range list.Items {
+			list.Items[i].ShoppingListID = id
+			if existing[list.Items[i].ID] {
+				kept = append(kept, list.Items[i].ID)
+			}
+		}
+		gone := db.Where("shopping_list_id = ?", id)
+		if len(kept) > 0 {
+			gone = gone.Where("id NOT IN ?", kept)
+		}
+		if err := gone.Delete(&domain.ShoppingItem{}).Error; err != nil {
+			return err
+		}
+		if err := db.Omit(clause.Associations).Save(list).Error; err != nil {
+			return err
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			var err error
+			if existing[item.ID] {
+				err = db.Omit("created_at").Save(item).Error
+			} else {
+				err = db.Create(item).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *shoppingListRepository) Delete(ctx context.Context, id string) error {
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		if err := db.Where("shopping_list_id = ?", id).Delete(&domain.ShoppingItem{}).Error; err != nil {
+			return err
+		}
+		return db.Delete(&domain.ShoppingList{}, "id = ?", id).Error
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+	}
+	return err
+}
+
+func (r *shoppingListRepository) AddItem(ctx context.Context, listID string, item *domain.ShoppingItem) (*domain.ShoppingItem, error) {
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		if err := db.First(&domain.ShoppingList{}, "id = ?", listID).Error; err != nil {
+			return err
+		}
+		// Positions can have gaps after deletes, so count would reuse one.
+		var next int
+		if err := db.Model(&domain.ShoppingItem{}).Where("shopping_list_id = ?", listID).
+			Select("COALESCE(MAX(position), -1) + 1").Scan(&next).Error; err != nil {
+			return err
+		}
+		item.ShoppingListID = listID
+		item.Position = next
+		return db.Create(item).Error
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return item, nil
+}
+
+func (r *shoppingListRepository) CheckItem(ctx context.Context, listID, itemID string, checked bool, userID string) (*domain.ShoppingItem, error) {
+	var item domain.ShoppingItem
+	err := r.resolver.Transaction(ctx, func(ctx context.Context) error {
+		db := r.resolver.Writer(ctx)
+		if err := db.First(&item, "id = ? AND shopping_list_id = ?", itemID, listID).Error; err != nil {
+			return err
+		}
+		item.Checked, item.CheckedBy = checked, userID
+		return db.Save(&item).Error
+	})
+	if err != nil {
+		logger.Default().ErrorContext(ctx, err.Error())
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *shoppingListRepository) DeleteItem(ctx context.Context, listID, itemID string) error {
+	result := r.resolver.Writer(ctx).Delete(&domain.ShoppingItem{}, "id = ? AND shopping_list_id = ?", itemID, listID)
+	if result.Error != nil {
+		logger.Default().ErrorContext(ctx, result.Error.Error())
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
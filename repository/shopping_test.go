@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupShoppingListRepository(t *testing.T) ShoppingListRepository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.ShoppingList{}, &domain.ShoppingItem{}))
+	return NewShoppingListRepository(db)
+}
+
+func itemNames(l domain.ShoppingList) []string {
+	var out []string
+	for _, item := range l.Items {
+		out = append(out, item.Name)
+	}
+	return out
+}
+
+func TestShoppingListRepository_CRUD(t *testing.T) {
+	repo := setupShoppingListRepository(t)
+	ctx := context.Background()
+	start := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+
+	list, err := repo.Create(ctx, &domain.ShoppingList{
+		HouseholdID: "h1", Name: "Week 19", StartDate: start, EndDate: start.AddDate(0, 0, 7),
+		Items: []domain.ShoppingItem{
+			{Name: "flour", Quantity: 1.2, Unit: "kg", Recipes: domain.StringList{"Bread"}},
+			{Name: "eggs", Quantity: 6},
+		},
+	})
+	require.NoError(t, err)
+
+	got, err := repo.Get(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"flour", "eggs"}, itemNames(*got))
+	assert.Equal(t, domain.StringList{"Bread"}, got.Items[0].Recipes)
+
+	eggs := got.Items[1]
+	got.Items = []domain.ShoppingItem{eggs, {Name: "milk", Quantity: 1, Unit: "l"}}
+	_, err = repo.Update(ctx, list.ID, got)
+	require.NoError(t, err)
+	got, err = repo.Get(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eggs", "milk"}, itemNames(*got), "update replaces the items")
+	assert.Equal(t, eggs.ID, got.Items[0].ID, "kept items keep their IDs")
+	assert.Equal(t, eggs.CreatedAt, got.Items[0].CreatedAt)
+	assert.NotEmpty(t, got.Items[1].ID)
+
+	got.Items[0].Checked = true
+	got.Items = append(got.Items, domain.ShoppingItem{BaseModel: domain.BaseModel{ID: "elsewhere"}, Name: "tea"})
+	_, err = repo.Update(ctx, list.ID, got)
+	require.NoError(t, err)
+	got, err = repo.Get(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eggs", "milk", "tea"}, itemNames(*got))
+	assert.True(t, got.Items[0].Checked)
+	assert.NotEqual(t, "elsewhere", got.Items[2].ID, "unknown IDs are replaced")
+
+	_, err = repo.Create(ctx, &domain.ShoppingList{HouseholdID: "h1", Name: "Later", StartDate: start.AddDate(0, 0, 7), EndDate: start.AddDate(0, 0, 14)})
+	require.NoError(t, err)
+	lists, err := repo.Find(ctx, ShoppingListFilter{HouseholdID: "h1"})
+	require.NoError(t, err)
+	require.Len(t, lists, 2)
+	assert.Equal(t, "Later", lists[0].Name, "latest first")
+	assert.Len(t, lists[1].Items, 3)
+
+	require.NoError(t, repo.Delete(ctx, list.ID))
+	_, err = repo.Get(ctx, list.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestShoppingListRepository_Items(t *testing.T) {
+	repo := setupShoppingListRepository(t)
+	ctx := context.Background()
+	list, err := repo.Create(ctx, &domain.ShoppingList{HouseholdID: "h1", Items: []domain.ShoppingItem{{Name: "eggs", Quantity: 6}}})
+	require.NoError(t, err)
+
+	added, err := repo.AddItem(ctx, list.ID, &domain.ShoppingItem{Name: "coffee", Manual: true})
+	require.NoError(t, err)
+	assert.Equal(t, 1, added.Position)
+	_, err = repo.AddItem(ctx, "missing", &domain.ShoppingItem{Name: "tea"})
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	_, err = repo.AddItem(ctx, list.ID, &domain.ShoppingItem{Name: " "})
+	assert.ErrorIs(t, err, domain.ErrInvalidShoppingList)
+
+	checked, err := repo.CheckItem(ctx, list.ID, added.ID, true, "u1")
+	require.NoError(t, err)
+	assert.True(t, checked.Checked)
+	got, err := repo.Get(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eggs", "coffee"}, itemNames(*got))
+	assert.Equal(t, "u1", got.Items[1].CheckedBy)
+
+	unchecked, err := repo.CheckItem(ctx, list.ID, added.ID, false, "u1")
+	require.NoError(t, err)
+	assert.Empty(t, unchecked.CheckedBy)
+	_, err = repo.CheckItem(ctx, "other", added.ID, true, "u1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	_, err = repo.AddItem(ctx, list.ID, &domain.ShoppingItem{Name: "tea", Manual: true})
+	require.NoError(t, err)
+	require.NoError(t, repo.DeleteItem(ctx, list.ID, added.ID))
+	assert.ErrorIs(t, repo.DeleteItem(ctx, list.ID, added.ID), gorm.ErrRecordNotFound)
+	sugar, err := repo.AddItem(ctx, list.ID, &domain.ShoppingItem{Name: "sugar", Manual: true})
+	require.NoError(t, err)
+	assert.Equal(t, 3, sugar.Position, "positions are not reused after a delete")
+}
@@ -0,0 +1,79 @@
+package rotation
+
+import (
+	"context"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+)
+
+// EventStore is the part of repository.EventRepository Apply needs.
+type EventStore interface {
+	Find(ctx context.Context, filter repository.EventFilter) ([]domain.Event, error)
+	Create(ctx context.Context, event *domain.Event) (*domain.Event, error)
+	Update(ctx context.Context, id string, event *domain.Event) (*domain.Event, error)
+}
+
+// Window is the period whose occurrences Apply assigns.
+type Window struct {
+	From, To time.Time
+	// Location is the household's time zone, in which ExcludedWeekdays and
+	// all-day occurrences are read; nil is UTC.
+	Location *time.Location
+}
+
+// Apply assigns the occurrences of series, as stored, in w and saves each
+// assignment as an override of its occurrence, or on the override the
+// occurrence already has. Occurrences already assigned to their member are
+// left alone. With dryRun nothing is saved.
+func Apply(ctx context.Context, store EventStore, series domain.Event, w Window, r Rotation, dryRun bool) ([]Assignment, error) {
+	if _, err := series.Recurrence(); err != nil {
+		return nil, err
+	}
+	if len(r.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+	events, err := store.Find(ctx, repository.EventFilter{SeriesID: series.ID, From: w.From, To: w.To, Location: w.Location})
+	if err != nil {
+		return nil, err
+	}
+	for i := range events {
+		events[i] = events[i].In(w.Location)
+	}
+	occurrences, err := domain.ExpandEvents(events, w.From, w.To)
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := r.Assign(occurrences)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return assignments, nil
+	}
+
+	series = series.In(w.Location)
+	for _, a := range assignments {
+		occ := a.Occurrence.Event
+		if a.UserID == "" || occ.AssignedTo == a.UserID {
+			continue
+		}
+		if occ.IsOverride() {
+			occ.AssignedTo = a.UserID
+			if _, err := store.Update(ctx, occ.ID, &occ); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		override, err := series.OverrideOccurrence(a.Occurrence.RecurrenceID)
+		if err != nil {
+			return nil, err
+		}
+		override.AssignedTo = a.UserID
+		if _, err := store.Create(ctx, &override); err != nil {
+			return nil, err
+		}
+	}
+	return assignments, nil
+}
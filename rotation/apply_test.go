@@ -0,0 +1,102 @@
+package rotation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupStore(t *testing.T) repository.EventRepository {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&domain.Event{}))
+	return repository.NewEventRepository(db)
+}
+
+func TestApply(t *testing.T) {
+	ctx := context.Background()
+	store := setupStore(t)
+	series, err := store.Create(ctx, &domain.Event{Name: "Bins", HouseholdID: "h1", StartDate: day(5), EndDate: day(5).Add(time.Hour), RRule: "FREQ=DAILY"})
+	require.NoError(t, err)
+	// The 6th was already moved to the afternoon.
+	rid := day(6)
+	moved, err := series.OverrideOccurrence(rid)
+	require.NoError(t, err)
+	moved.StartDate, moved.EndDate = day(6).Add(5*time.Hour), day(6).Add(6*time.Hour)
+	_, err = store.Create(ctx, &moved)
+	require.NoError(t, err)
+
+	r := Rotation{Members: []Member{{UserID: "ann"}, {UserID: "bob"}}}
+	w := Window{From: day(5), To: day(8)}
+
+	planned, err := Apply(ctx, store, *series, w, r, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ann", "bob", "ann"}, users(planned))
+	events, err := store.Find(ctx, repository.EventFilter{SeriesID: series.ID})
+	require.NoError(t, err)
+	assert.Len(t, events, 2, "a dry run saves nothing")
+
+	_, err = Apply(ctx, store, *series, w, r, false)
+	require.NoError(t, err)
+	occurrences, err := store.Occurrences(ctx, repository.EventFilter{HouseholdID: "h1", From: day(5), To: day(8)})
+	require.NoError(t, err)
+	require.Len(t, occurrences, 3)
+	var got []string
+	for _, o := range occurrences {
+		got = append(got, o.Event.AssignedTo)
+	}
+	assert.Equal(t, []string{"ann", "bob", "ann"}, got)
+	assert.Equal(t, 14, occurrences[1].Event.StartDate.Hour(), "the moved occurrence keeps its time")
+
+	events, err = store.Find(ctx, repository.EventFilter{SeriesID: series.ID})
+	require.NoError(t, err)
+	assert.Len(t, events, 4, "the existing override is updated, not duplicated")
+
+	// Running it again changes nothing.
+	_, err = Apply(ctx, store, *series, w, r, false)
+	require.NoError(t, err)
+	events, err = store.Find(ctx, repository.EventFilter{SeriesID: series.ID})
+	require.NoError(t, err)
+	assert.Len(t, events, 4)
+
+	assert.Equal(t, []Workload{
+		{UserID: "ann", Count: 2, Duration: 2 * time.Hour},
+		{UserID: "bob", Count: 1, Duration: time.Hour},
+	}, Summarize(occurrences))
+}
+
+func TestApply_AllDayInTimeZone(t *testing.T) {
+	ctx := context.Background()
+	store := setupStore(t)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	start := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	series, err := store.Create(ctx, &domain.Event{Name: "Laundry", HouseholdID: "h1", AllDay: true, StartDate: start, EndDate: start.AddDate(0, 0, 1), RRule: "FREQ=DAILY"})
+	require.NoError(t, err)
+
+	// Bob never does Tuesdays, the 6th.
+	r := Rotation{Members: []Member{{UserID: "bob", ExcludedWeekdays: []time.Weekday{time.Tuesday}}, {UserID: "ann"}}}
+	w := Window{From: time.Date(2025, 5, 5, 0, 0, 0, 0, tokyo), To: time.Date(2025, 5, 7, 0, 0, 0, 0, tokyo), Location: tokyo}
+	assignments, err := Apply(ctx, store, *series, w, r, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bob", "ann"}, users(assignments))
+
+	events, err := store.Find(ctx, repository.EventFilter{SeriesID: series.ID})
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for _, e := range events[1:] {
+		assert.Equal(t, 0, e.StartDate.UTC().Hour(), "all-day overrides are stored as dates")
+		assert.True(t, e.RecurrenceID.Equal(e.StartDate))
+	}
+}
+
+func TestApply_NotRecurring(t *testing.T) {
+	_, err := Apply(context.Background(), setupStore(t), domain.Event{}, Window{}, Rotation{}, true)
+	assert.ErrorIs(t, err, domain.ErrNotRecurring)
+}
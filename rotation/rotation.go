@@ -0,0 +1,128 @@
+// Package rotation shares the occurrences of recurring events, such as
+// chores, among household members, and summarizes who does how much.
+package rotation
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+type Strategy string
+
+const (
+	// RoundRobin gives occurrences to members in turn.
+	RoundRobin Strategy = "round_robin"
+	// LeastLoad gives each occurrence to the member with the least work so
+	// far, counting Rotation.Load.
+	LeastLoad Strategy = "least_load"
+)
+
+var (
+	ErrNoMembers       = fmt.Errorf("%w: rotation has no members", domain.ErrInvalidArgument)
+	ErrUnknownStrategy = fmt.Errorf("%w: unknown rotation strategy", domain.ErrInvalidArgument)
+)
+
+// Period is the span [Start, End).
+type Period struct {
+	Start, End time.Time
+}
+
+// Member is someone who takes part in a rotation.
+type Member struct {
+	UserID string
+	// Unavailable are the periods the member cannot take an occurrence that
+	// overlaps.
+	Unavailable []Period
+	// ExcludedWeekdays are the days, in the occurrence's own time zone, on
+	// which the member never takes an occurrence.
+	ExcludedWeekdays []time.Weekday
+}
+
+// Available reports whether m can take an occurrence from start to end.
+func (m Member) Available(start, end time.Time) bool {
+	if slices.Contains(m.ExcludedWeekdays, start.Weekday()) {
+		return false
+	}
+	for _, p := range m.Unavailable {
+		if start.Before(p.End) && (end.After(p.Start) || (!end.After(start) && !start.Before(p.Start))) {
+			return false
+		}
+	}
+	return true
+}
+
+// Rotation says who shares an event's occurrences and how.
+type Rotation struct {
+	Strategy Strategy
+	// Members in turn order.
+	Members []Member
+	// After is the member who took the occurrence before the first one
+	// assigned, so a round robin carries on from them. Empty starts with the
+	// first member.
+	After string
+	// Load is the work members already have, e.g. from Summarize, which
+	// LeastLoad adds to.
+	Load map[string]Workload
+}
+
+// Assignment gives an occurrence to a member. UserID is empty if no member
+// was available.
+type Assignment struct {
+	Occurrence domain.Occurrence
+	UserID     string
+}
+
+// Assign shares occurrences, in order, among r's members. A member who is
+// unavailable keeps their place in a round robin and takes the next
+// occurrence they can.
+func (r Rotation) Assign(occurrences []domain.Occurrence) ([]Assignment, error) {
+	if len(r.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+	if r.Strategy == "" {
+		r.Strategy = RoundRobin
+	}
+	if r.Strategy != RoundRobin && r.Strategy != LeastLoad {
+		return nil, fmt.Errorf("%w %q", ErrUnknownStrategy, r.Strategy)
+	}
+
+	queue := slices.Clone(r.Members)
+	if i := slices.IndexFunc(queue, func(m Member) bool { return m.UserID == r.After }); i >= 0 {
+		queue = append(queue[i+1:], queue[:i+1]...)
+	}
+	load := make(map[string]Workload, len(queue))
+	for _, m := range queue {
+		load[m.UserID] = r.Load[m.UserID]
+	}
+
+	out := make([]Assignment, len(occurrences))
+	for i, occ := range occurrences {
+		out[i].Occurrence = occ
+		start, end := occ.Event.StartDate, occ.Event.EndDate
+		pick := -1
+		for j, m := range queue {
+			if !m.Available(start, end) {
+				continue
+			}
+			if pick < 0 || (r.Strategy == LeastLoad && load[m.UserID].less(load[queue[pick].UserID])) {
+				pick = j
+			}
+			if r.Strategy == RoundRobin {
+				break
+			}
+		}
+		if pick < 0 {
+			continue
+		}
+		m := queue[pick]
+		out[i].UserID = m.UserID
+		load[m.UserID] = load[m.UserID].add(occ)
+		// The member goes to the back of the queue; those they skipped keep
+		// their places ahead of them.
+		queue = append(append(queue[:pick:pick], queue[pick+1:]...), m)
+	}
+	return out, nil
+}
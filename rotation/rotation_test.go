@@ -0,0 +1,90 @@
+package rotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// day returns 9am on the given day of May 2025; 5 May is a Monday.
+func day(d int) time.Time {
+	return time.Date(2025, 5, d, 9, 0, 0, 0, time.UTC)
+}
+
+func daily(t *testing.T, from, to int, length time.Duration) []domain.Occurrence {
+	series := domain.Event{BaseModel: domain.BaseModel{ID: "s1"}, StartDate: day(from), EndDate: day(from).Add(length), RRule: "FREQ=DAILY"}
+	occurrences, err := series.Occurrences(day(from), day(to))
+	require.NoError(t, err)
+	return occurrences
+}
+
+func users(assignments []Assignment) []string {
+	var out []string
+	for _, a := range assignments {
+		out = append(out, a.UserID)
+	}
+	return out
+}
+
+func TestAssign_RoundRobin(t *testing.T) {
+	r := Rotation{Members: []Member{{UserID: "ann"}, {UserID: "bob"}, {UserID: "cat"}}}
+
+	got, err := r.Assign(daily(t, 5, 10, time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ann", "bob", "cat", "ann", "bob"}, users(got))
+
+	r.After = "bob"
+	got, err = r.Assign(daily(t, 5, 8, time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat", "ann", "bob"}, users(got))
+}
+
+func TestAssign_Availability(t *testing.T) {
+	r := Rotation{Members: []Member{
+		{UserID: "ann", Unavailable: []Period{{Start: day(6), End: day(8)}}},
+		{UserID: "bob", ExcludedWeekdays: []time.Weekday{time.Friday}},
+	}}
+
+	got, err := r.Assign(daily(t, 5, 11, time.Hour))
+	require.NoError(t, err)
+	// Ann is away on the 6th and 7th and keeps her turn; Bob never does
+	// Fridays (the 9th).
+	assert.Equal(t, []string{"ann", "bob", "bob", "ann", "ann", "bob"}, users(got))
+
+	r.Members = r.Members[1:]
+	got, err = r.Assign(daily(t, 9, 10, time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{""}, users(got), "nobody is available")
+}
+
+func TestAssign_LeastLoad(t *testing.T) {
+	r := Rotation{
+		Strategy: LeastLoad,
+		Members:  []Member{{UserID: "ann"}, {UserID: "bob"}, {UserID: "cat"}},
+		Load:     map[string]Workload{"ann": {Count: 2, Duration: 2 * time.Hour}, "cat": {Count: 1, Duration: 30 * time.Minute}},
+	}
+
+	got, err := r.Assign(daily(t, 5, 10, time.Hour))
+	require.NoError(t, err)
+	// Bob starts with nothing; Cat catches up to Ann before Ann gets more.
+	assert.Equal(t, []string{"bob", "cat", "bob", "cat", "ann"}, users(got))
+}
+
+func TestAssign_Errors(t *testing.T) {
+	_, err := Rotation{}.Assign(nil)
+	assert.ErrorIs(t, err, ErrNoMembers)
+
+	_, err = Rotation{Strategy: "random", Members: []Member{{UserID: "ann"}}}.Assign(nil)
+	assert.ErrorIs(t, err, ErrUnknownStrategy)
+}
+
+func TestMember_Available(t *testing.T) {
+	m := Member{Unavailable: []Period{{Start: day(5), End: day(5).Add(time.Hour)}}}
+	assert.False(t, m.Available(day(5).Add(30*time.Minute), day(5).Add(2*time.Hour)))
+	assert.False(t, m.Available(day(5), day(5)), "an instant inside the period")
+	assert.True(t, m.Available(day(5).Add(time.Hour), day(5).Add(2*time.Hour)), "periods are half open")
+	assert.True(t, m.Available(day(5).Add(-time.Hour), day(5)))
+}
@@ -0,0 +1,63 @@
+package rotation
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+)
+
+// Workload is how much a member is assigned.
+type Workload struct {
+	UserID   string
+	Count    int
+	Duration time.Duration
+}
+
+func (w Workload) add(occ domain.Occurrence) Workload {
+	w.Count++
+	w.Duration += occ.Event.EndDate.Sub(occ.Event.StartDate)
+	return w
+}
+
+// less compares by time spent, then by number of occurrences, so that
+// instant reminders still count.
+func (w Workload) less(other Workload) bool {
+	if w.Duration != other.Duration {
+		return w.Duration < other.Duration
+	}
+	return w.Count < other.Count
+}
+
+// Summarize totals the occurrences assigned to each user, such as those
+// repository.EventRepository.Occurrences returns for a period. userIDs are
+// included even without any; unassigned occurrences are totalled under the
+// empty user ID if there are any. Workloads are ordered by user ID.
+func Summarize(occurrences []domain.Occurrence, userIDs ...string) []Workload {
+	totals := make(map[string]Workload, len(userIDs))
+	for _, id := range userIDs {
+		totals[id] = Workload{UserID: id}
+	}
+	for _, occ := range occurrences {
+		id := occ.Event.AssignedTo
+		w := totals[id]
+		w.UserID = id
+		totals[id] = w.add(occ)
+	}
+	out := make([]Workload, 0, len(totals))
+	for _, w := range totals {
+		out = append(out, w)
+	}
+	slices.SortFunc(out, func(a, b Workload) int { return strings.Compare(a.UserID, b.UserID) })
+	return out
+}
+
+// Loads indexes workloads by user ID, for Rotation.Load.
+func Loads(workloads []Workload) map[string]Workload {
+	out := make(map[string]Workload, len(workloads))
+	for _, w := range workloads {
+		out[w.UserID] = w
+	}
+	return out
+}
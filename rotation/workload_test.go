@@ -0,0 +1,30 @@
+package rotation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	occurrences := daily(t, 5, 9, 30*time.Minute)
+	for i, id := range []string{"ann", "bob", "ann", ""} {
+		occurrences[i].Event.AssignedTo = id
+	}
+	reminder := domain.Occurrence{Event: domain.Event{AssignedTo: "bob", StartDate: day(9), EndDate: day(9)}}
+
+	got := Summarize(append(occurrences, reminder), "ann", "bob", "cat")
+	assert.Equal(t, []Workload{
+		{UserID: "", Count: 1, Duration: 30 * time.Minute},
+		{UserID: "ann", Count: 2, Duration: time.Hour},
+		{UserID: "bob", Count: 2, Duration: 30 * time.Minute},
+		{UserID: "cat"},
+	}, got)
+
+	loads := Loads(got)
+	assert.Equal(t, 2, loads["ann"].Count)
+	assert.True(t, loads["bob"].less(loads["ann"]))
+	assert.True(t, Workload{Count: 1}.less(Workload{Count: 2}), "counts break ties")
+}
@@ -0,0 +1,128 @@
+// Package shopping builds households' shopping lists from their meal plans.
+package shopping
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+)
+
+// PlanFinder is the part of repository.MealPlanRepository Generate needs.
+type PlanFinder interface {
+	Find(ctx context.Context, filter repository.MealPlanFilter) ([]domain.MealPlanEntry, error)
+}
+
+// key identifies the items that add up: the same ingredient in the same base
+// unit.
+type key struct {
+	name, base string
+}
+
+func keyOf(name, unitName string) key {
+	_, base := Normalize(0, unitName)
+	return key{strings.Join(strings.Fields(strings.ToLower(name)), " "), base}
+}
+
+// Aggregate totals the ingredients of the planned meals' recipes, scaled to
+// each entry's servings, into one item per ingredient and unit, ordered by
+// name. Entries must have their recipes loaded. An ingredient used in both
+// weights and volumes is listed once for each.
+func Aggregate(entries []domain.MealPlanEntry) []domain.ShoppingItem {
+	totals := map[key]*domain.ShoppingItem{}
+	var order []key
+	for _, e := range entries {
+		if e.Recipe == nil {
+			continue
+		}
+		scale := 1.0
+		if e.Servings > 0 && e.Recipe.Servings > 0 {
+			scale = float64(e.Servings) / float64(e.Recipe.Servings)
+		}
+		for _, in := range e.Recipe.Ingredients {
+			quantity, base := Normalize(in.Quantity*scale, in.Unit)
+			k := keyOf(in.Name, in.Unit)
+			item, ok := totals[k]
+			if !ok {
+				item = &domain.ShoppingItem{Name: strings.TrimSpace(in.Name), Unit: base}
+				totals[k] = item
+				order = append(order, k)
+			}
+			item.Quantity += quantity
+			if !slices.Contains(item.Recipes, e.Recipe.Name) {
+				item.Recipes = append(item.Recipes, e.Recipe.Name)
+			}
+		}
+	}
+
+	slices.SortStableFunc(order, func(a, b key) int {
+		if c := strings.Compare(a.name, b.name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.base, b.base)
+	})
+	items := make([]domain.ShoppingItem, len(order))
+	for i, k := range order {
+		item := *totals[k]
+		item.Quantity, item.Unit = Display(item.Quantity, item.Unit)
+		items[i] = item
+	}
+	return items
+}
+
+// Merge replaces the generated items of current with generated, keeping
+// manual items at the end. A generated item that was already on the list
+// keeps its ID, and stays checked off if no more of it is needed.
+func Merge(current, generated []domain.ShoppingItem) []domain.ShoppingItem {
+	previous := map[key]domain.ShoppingItem{}
+	var manual []domain.ShoppingItem
+	for _, item := range current {
+		if item.Manual {
+			manual = append(manual, item)
+		} else {
+			previous[keyOf(item.Name, item.Unit)] = item
+		}
+	}
+
+	out := make([]domain.ShoppingItem, 0, len(generated)+len(manual))
+	for _, item := range generated {
+		old, ok := previous[keyOf(item.Name, item.Unit)]
+		if ok {
+			item.BaseModel = old.BaseModel
+		}
+		if ok && old.Checked && covers(old, item) {
+			item.Checked, item.CheckedBy = true, old.CheckedBy
+		}
+		out = append(out, item)
+	}
+	return append(out, manual...)
+}
+
+// covers reports whether the amount bought for old is enough for item.
+func covers(old, item domain.ShoppingItem) bool {
+	had, _ := Normalize(old.Quantity, old.Unit)
+	need, _ := Normalize(item.Quantity, item.Unit)
+	return had >= need
+}
+
+// Generate fills list.Items from the household's meal plan between the list's
+// dates, keeping manual items and checked-off items as Merge does. The list
+// is not saved.
+func Generate(ctx context.Context, plans PlanFinder, list *domain.ShoppingList) error {
+	if list.StartDate.IsZero() || list.EndDate.IsZero() {
+		return fmt.Errorf("%w: start and end dates are required", domain.ErrInvalidShoppingList)
+	}
+	entries, err := plans.Find(ctx, repository.MealPlanFilter{
+		HouseholdID: list.HouseholdID,
+		From:        list.StartDate,
+		To:          list.EndDate,
+	})
+	if err != nil {
+		return err
+	}
+	list.Items = Merge(list.Items, Aggregate(entries))
+	return nil
+}
@@ -0,0 +1,104 @@
+package shopping
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hmlylab/common/domain"
+	"github.com/hmlylab/common/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pancakes = &domain.Recipe{Name: "Pancakes", Servings: 4, Ingredients: []domain.Ingredient{
+		{Name: "Flour", Quantity: 200, Unit: "g"},
+		{Name: "milk", Quantity: 300, Unit: "ml"},
+		{Name: "eggs", Quantity: 2},
+		{Name: "salt"},
+	}}
+	bread = &domain.Recipe{Name: "Bread", Servings: 1, Ingredients: []domain.Ingredient{
+		{Name: "flour", Quantity: 1, Unit: "kg"},
+		{Name: "milk", Quantity: 1, Unit: "cup"},
+		{Name: "flour", Quantity: 2, Unit: "tbsp"},
+	}}
+)
+
+func TestAggregate(t *testing.T) {
+	items := Aggregate([]domain.MealPlanEntry{
+		{Recipe: pancakes, Servings: 8},
+		{Recipe: bread},
+		{Recipe: pancakes},
+		{RecipeID: "not loaded"},
+	})
+
+	type line struct {
+		Name     string
+		Quantity float64
+		Unit     string
+	}
+	var got []line
+	for _, item := range items {
+		got = append(got, line{item.Name, item.Quantity, item.Unit})
+	}
+	assert.Equal(t, []line{
+		{"eggs", 6, Count},
+		{"Flour", 1.6, "kg"},
+		{"flour", 29.574, Millilitre},
+		{"milk", 1.137, "l"},
+		{"salt", 0, Count},
+	}, got)
+	assert.Equal(t, domain.StringList{"Pancakes", "Bread"}, items[1].Recipes)
+	assert.Equal(t, domain.StringList{"Pancakes"}, items[0].Recipes)
+}
+
+func TestMerge(t *testing.T) {
+	current := []domain.ShoppingItem{
+		{BaseModel: domain.BaseModel{ID: "i1"}, Name: "eggs", Quantity: 6, Checked: true, CheckedBy: "u1"},
+		{BaseModel: domain.BaseModel{ID: "i2"}, Name: "milk", Quantity: 500, Unit: "ml", Checked: true, CheckedBy: "u1"},
+		{BaseModel: domain.BaseModel{ID: "i3"}, Name: "flour", Quantity: 1, Unit: "kg"},
+		{Name: "bin bags", Quantity: 1, Manual: true, Checked: true, CheckedBy: "u2"},
+	}
+	generated := []domain.ShoppingItem{
+		{Name: "eggs", Quantity: 4},
+		{Name: "flour", Quantity: 1.5, Unit: "kg"},
+		{Name: "milk", Quantity: 1, Unit: "l"},
+	}
+
+	got := Merge(current, generated)
+	require.Len(t, got, 4)
+	assert.True(t, got[0].Checked, "enough eggs were bought")
+	assert.Equal(t, "u1", got[0].CheckedBy)
+	assert.False(t, got[1].Checked)
+	assert.False(t, got[2].Checked, "more milk is needed than was bought")
+	assert.Equal(t, current[3], got[3], "manual items are kept as they were")
+	assert.Equal(t, []string{"i1", "i3", "i2"}, []string{got[0].ID, got[1].ID, got[2].ID}, "items keep their IDs")
+}
+
+type planFinder struct {
+	filter  repository.MealPlanFilter
+	entries []domain.MealPlanEntry
+}
+
+func (f *planFinder) Find(_ context.Context, filter repository.MealPlanFilter) ([]domain.MealPlanEntry, error) {
+	f.filter = filter
+	return f.entries, nil
+}
+
+func TestGenerate(t *testing.T) {
+	plans := &planFinder{entries: []domain.MealPlanEntry{{Recipe: bread}}}
+	start := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	list := &domain.ShoppingList{
+		HouseholdID: "h1", StartDate: start, EndDate: start.AddDate(0, 0, 7),
+		Items: []domain.ShoppingItem{{Name: "coffee", Manual: true}},
+	}
+
+	require.NoError(t, Generate(context.Background(), plans, list))
+	assert.Equal(t, repository.MealPlanFilter{HouseholdID: "h1", From: start, To: start.AddDate(0, 0, 7)}, plans.filter)
+	require.Len(t, list.Items, 4)
+	assert.Equal(t, "coffee", list.Items[3].Name)
+
+	err := Generate(context.Background(), plans, &domain.ShoppingList{HouseholdID: "h1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidShoppingList)
+}
@@ -0,0 +1,74 @@
+package shopping
+
+import (
+	"math"
+	"strings"
+)
+
+// Base units quantities are summed in. Count is for things bought by number,
+// e.g. 3 eggs.
+const (
+	Gram       = "g"
+	Millilitre = "ml"
+	Count      = ""
+)
+
+type unit struct {
+	base   string
+	factor float64
+}
+
+// units maps the spellings recipes use to a base unit. Cups and spoons are
+// US customary measures.
+var units = map[string]unit{
+	"mg": {Gram, 0.001}, "milligram": {Gram, 0.001},
+	"g": {Gram, 1}, "gr": {Gram, 1}, "gram": {Gram, 1}, "gramme": {Gram, 1},
+	"kg": {Gram, 1000}, "kilo": {Gram, 1000}, "kilogram": {Gram, 1000},
+	"oz": {Gram, 28.349523125}, "ounce": {Gram, 28.349523125},
+	"lb": {Gram, 453.59237}, "pound": {Gram, 453.59237},
+
+	"ml": {Millilitre, 1}, "millilitre": {Millilitre, 1}, "milliliter": {Millilitre, 1},
+	"cl": {Millilitre, 10}, "dl": {Millilitre, 100},
+	"l": {Millilitre, 1000}, "litre": {Millilitre, 1000}, "liter": {Millilitre, 1000},
+	"tsp": {Millilitre, 4.92892159375}, "teaspoon": {Millilitre, 4.92892159375},
+	"tbsp": {Millilitre, 14.78676478125}, "tablespoon": {Millilitre, 14.78676478125},
+	"cup": {Millilitre, 236.5882365}, "c": {Millilitre, 236.5882365},
+	"fl oz": {Millilitre, 29.5735295625}, "floz": {Millilitre, 29.5735295625},
+
+	"": {Count, 1}, "pc": {Count, 1}, "pcs": {Count, 1}, "piece": {Count, 1},
+}
+
+// lookup finds the unit s is spelled as, ignoring case, a trailing full stop
+// and plural "s".
+func lookup(s string) (unit, bool) {
+	s = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), ".")
+	if u, ok := units[s]; ok {
+		return u, true
+	}
+	u, ok := units[strings.TrimSuffix(s, "s")]
+	return u, ok
+}
+
+// Normalize converts quantity in unit to its base unit: grams for weights,
+// millilitres for volumes and Count for pieces. Units it does not know, such
+// as "clove" or "can", are lower-cased and returned unconverted, so they only
+// add up with the same unit.
+func Normalize(quantity float64, unitName string) (float64, string) {
+	if u, ok := lookup(unitName); ok {
+		return quantity * u.factor, u.base
+	}
+	return quantity, strings.ToLower(strings.TrimSpace(unitName))
+}
+
+// Display converts a quantity in a base unit to the one a shopper reads:
+// kilograms and litres from 1000 up. Quantities are rounded to three
+// decimal places.
+func Display(quantity float64, base string) (float64, string) {
+	switch {
+	case base == Gram && quantity >= 1000:
+		quantity, base = quantity/1000, "kg"
+	case base == Millilitre && quantity >= 1000:
+		quantity, base = quantity/1000, "l"
+	}
+	return math.Round(quantity*1000) / 1000, base
+}
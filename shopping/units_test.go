@@ -0,0 +1,44 @@
+package shopping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range []struct {
+		quantity float64
+		unit     string
+		want     float64
+		wantUnit string
+	}{
+		{2, "kg", 2000, Gram},
+		{250, "Grams", 250, Gram},
+		{1, "lb", 453.59237, Gram},
+		{1.5, "l", 1500, Millilitre},
+		{2, "cups", 473.176473, Millilitre},
+		{1, "Tbsp.", 14.78676478125, Millilitre},
+		{3, "", 3, Count},
+		{4, "pieces", 4, Count},
+		{2, " Cloves ", 2, "cloves"},
+	} {
+		got, unit := Normalize(tt.quantity, tt.unit)
+		assert.InDelta(t, tt.want, got, 1e-9, tt.unit)
+		assert.Equal(t, tt.wantUnit, unit, tt.unit)
+	}
+}
+
+func TestDisplay(t *testing.T) {
+	q, u := Display(1250, Gram)
+	assert.Equal(t, 1.25, q)
+	assert.Equal(t, "kg", u)
+
+	q, u = Display(473.176473, Millilitre)
+	assert.Equal(t, 473.176, q)
+	assert.Equal(t, Millilitre, u)
+
+	q, u = Display(3, "cans")
+	assert.Equal(t, 3.0, q)
+	assert.Equal(t, "cans", u)
+}
@@ -1,23 +1,47 @@
 package utils
 
 import (
+	"crypto/tls"
 	"os"
 
+	"github.com/hmlylab/common/config"
 	"github.com/hmlylab/common/logger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-var (
-	log = logger.NewLogger()
-)
-
 func ConnectToGrpcClient(name, addr string) *grpc.ClientConn {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(
-		insecure.NewCredentials(),
-	))
+	return connect(name, addr, insecure.NewCredentials())
+}
+
+// ConnectToService connects to the named service in services, with TLS if the
+// service sets tls.
+func ConnectToService(services config.ServicesConfig, name string) *grpc.ClientConn {
+	target, err := services.Target(name)
+	if err != nil {
+		logger.Default().Error("Failed to connect to gRPC server: " + name + " " + err.Error())
+		os.Exit(1)
+	}
+	svc, _ := services.Lookup(name)
+	return connect(name, target, serviceCredentials(svc))
+}
+
+func serviceCredentials(svc config.ServiceConfig) credentials.TransportCredentials {
+	if svc.TLS {
+		return credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	return insecure.NewCredentials()
+}
+
+func connect(name, addr string, creds credentials.TransportCredentials) *grpc.ClientConn {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(logger.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(logger.StreamClientInterceptor()),
+	)
 	if err != nil {
-		log.Error("Failed to connect to gRPC server: " + name + " " + err.Error())
+		logger.Default().Error("Failed to connect to gRPC server: " + name + " " + err.Error())
 		os.Exit(1) // Exit if we can't connect to the gRPC server
 	}
 	return conn
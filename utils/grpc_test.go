@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hmlylab/common/config"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -165,4 +166,24 @@ func BenchmarkConnectToGrpcClient(b *testing.B) {
 		conn.Close()
 		cancel()
 	}
-}
\ No newline at end of file
+}
+
+func TestConnectToService(t *testing.T) {
+	services := config.ServicesConfig{
+		"meal":  {Host: "meal", Port: 50051},
+		"event": {Host: "events.internal:443", TLS: true},
+	}
+
+	conn := ConnectToService(services, "Meal")
+	defer conn.Close()
+	if conn.Target() != "meal:50051" {
+		t.Errorf("Target() = %q, want meal:50051", conn.Target())
+	}
+
+	if got := serviceCredentials(services["meal"]).Info().SecurityProtocol; got != "insecure" {
+		t.Errorf("Expected insecure credentials without tls, got %q", got)
+	}
+	if got := serviceCredentials(services["event"]).Info().SecurityProtocol; got != "tls" {
+		t.Errorf("Expected TLS credentials, got %q", got)
+	}
+}
@@ -1,10 +1,93 @@
 package utils
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
+const DateLayout = time.DateOnly
+
+var ErrInvalidTime = errors.New("invalid time")
+
+// FormatTime formats t as RFC3339 in the server's local zone. Use
+// FormatTimeIn to format for a household.
 func FormatTime(t time.Time) string {
 	if t.IsZero() {
 		return ""
 	}
 	return t.Local().Format(time.RFC3339)
 }
+
+// FormatTimeIn formats t as RFC3339 in loc, with loc's offset; nil is UTC.
+func FormatTimeIn(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// FormatDate formats the date t falls on in loc as YYYY-MM-DD; nil is UTC.
+func FormatDate(t time.Time, loc *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(DateLayout)
+}
+
+// LoadLocation is time.LoadLocation, except that an empty name is UTC rather
+// than the server's zone.
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalidTime, name)
+	}
+	return loc, nil
+}
+
+// ParseTime parses an RFC3339 time, or a date or time without an offset as
+// wall clock time in loc (nil is UTC). A date alone is midnight. The result is
+// in UTC, as times are stored; an empty string is the zero time.
+func ParseTime(s string, loc *time.Location) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC(), nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	for _, layout := range []string{"2006-01-02T15:04:05.999999999", "2006-01-02T15:04", DateLayout} {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q is not an RFC3339 time or a date", ErrInvalidTime, s)
+}
+
+// ParseDate parses a YYYY-MM-DD date, or the date part of an RFC3339 time in
+// its own offset, as midnight UTC, the way all-day events store dates.
+func ParseDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		ts, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q is not a date", ErrInvalidTime, s)
+		}
+		t = time.Date(ts.Year(), ts.Month(), ts.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t, nil
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -76,4 +77,114 @@ func TestFormatTime_EdgeCases(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestFormatTimeIn(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	input := time.Date(2024, 1, 15, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		loc      *time.Location
+		expected string
+	}{
+		{name: "zero time", input: time.Time{}, loc: tokyo, expected: ""},
+		{name: "nil location", input: input, loc: nil, expected: "2024-01-15T18:30:00Z"},
+		{name: "offset", input: input, loc: tokyo, expected: "2024-01-16T03:30:00+09:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FormatTimeIn(tt.input, tt.loc); result != tt.expected {
+				t.Errorf("FormatTimeIn() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+
+	if result := FormatDate(input, tokyo); result != "2024-01-16" {
+		t.Errorf("FormatDate() = %v, want 2024-01-16", result)
+	}
+	if result := FormatDate(input, nil); result != "2024-01-15" {
+		t.Errorf("FormatDate() = %v, want 2024-01-15", result)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name     string
+		input    string
+		loc      *time.Location
+		expected time.Time
+		wantErr  bool
+	}{
+		{name: "empty", input: "", expected: time.Time{}},
+		{name: "utc", input: "2024-01-15T18:30:00Z", loc: tokyo, expected: time.Date(2024, 1, 15, 18, 30, 0, 0, time.UTC)},
+		{name: "offset", input: "2024-01-15T18:30:00+02:00", loc: tokyo, expected: time.Date(2024, 1, 15, 16, 30, 0, 0, time.UTC)},
+		{name: "fraction", input: "2024-01-15T18:30:00.5Z", expected: time.Date(2024, 1, 15, 18, 30, 0, 5e8, time.UTC)},
+		{name: "wall clock", input: "2024-01-15T18:30:00", loc: tokyo, expected: time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC)},
+		{name: "minutes", input: "2024-01-15T18:30", expected: time.Date(2024, 1, 15, 18, 30, 0, 0, time.UTC)},
+		{name: "date", input: "2024-01-15", loc: tokyo, expected: time.Date(2024, 1, 14, 15, 0, 0, 0, time.UTC)},
+		{name: "invalid", input: "15/01/2024", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTime(tt.input, tt.loc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !result.Equal(tt.expected) || (!result.IsZero() && result.Location() != time.UTC) {
+				t.Errorf("ParseTime() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTime_DST(t *testing.T) {
+	london, err := LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip("no tzdata:", err)
+	}
+	winter, _ := ParseTime("2025-03-28T18:00:00", london)
+	summer, _ := ParseTime("2025-04-04T18:00:00", london)
+	if winter.Hour() != 18 || summer.Hour() != 17 {
+		t.Errorf("Expected 6pm to be stored as 18:00 and 17:00 UTC, got %v and %v", winter, summer)
+	}
+	if FormatTimeIn(summer, london) != "2025-04-04T18:00:00+01:00" {
+		t.Errorf("FormatTimeIn() = %v", FormatTimeIn(summer, london))
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+		wantErr  bool
+	}{
+		{input: "", expected: time.Time{}},
+		{input: "2024-02-29", expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{input: "2024-02-29T23:30:00-05:00", expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{input: "2024-02-30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		result, err := ParseDate(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDate(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+		}
+		if !result.Equal(tt.expected) {
+			t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	if loc, err := LoadLocation(""); err != nil || loc != time.UTC {
+		t.Errorf("LoadLocation(\"\") = %v, %v; want UTC", loc, err)
+	}
+	if _, err := LoadLocation("Mars/Olympus"); !errors.Is(err, ErrInvalidTime) {
+		t.Errorf("LoadLocation() error = %v, want ErrInvalidTime", err)
+	}
+}